@@ -1,8 +1,13 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
+	"strings"
+	"time"
 )
 
 // ============================================
@@ -22,7 +27,7 @@ type Shape interface {
 
 // Circle type - implements Shape interface implicitly
 type Circle struct {
-	Radius float64
+	Radius float64 `json:"radius"`
 }
 
 // Area method for Circle - implementing Shape interface
@@ -37,8 +42,8 @@ func (c Circle) Perimeter() float64 {
 
 // Rectangle type - also implements Shape interface
 type Rectangle struct {
-	Width  float64
-	Height float64
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
 }
 
 // Area method for Rectangle
@@ -139,20 +144,43 @@ func describeShape(s Shape) {
 // 9. PRACTICAL EXAMPLE: PAYMENT PROCESSING
 // ============================================
 
+// Errors returned by Pay
+// Sentinel errors let callers check the reason with errors.Is
+var (
+	ErrInvalidAmount = errors.New("invalid payment amount")
+	ErrOverLimit     = errors.New("payment exceeds card limit")
+)
+
 // PaymentMethod interface for different payment types
 type PaymentMethod interface {
-	Pay(amount float64) string
+	Pay(amount float64) (string, error)
+}
+
+// validateAmount is shared by every payment method and refund
+// NaN fails every comparison, so "not >= 0" rejects it along with negatives
+func validateAmount(amount float64) error {
+	if !(amount >= 0) {
+		return fmt.Errorf("%w: %.2f", ErrInvalidAmount, amount)
+	}
+	return nil
 }
 
 // CreditCard type
 type CreditCard struct {
 	CardNumber string
 	CardHolder string
+	Limit      float64 // Maximum single charge; 0 means no limit
 }
 
-func (cc CreditCard) Pay(amount float64) string {
+func (cc CreditCard) Pay(amount float64) (string, error) {
+	if err := validateAmount(amount); err != nil {
+		return "", err
+	}
+	if cc.Limit > 0 && amount > cc.Limit {
+		return "", fmt.Errorf("%w: $%.2f > $%.2f", ErrOverLimit, amount, cc.Limit)
+	}
 	return fmt.Sprintf("Paid $%.2f using Credit Card ending in %s",
-		amount, cc.CardNumber[len(cc.CardNumber)-4:])
+		amount, cc.CardNumber[len(cc.CardNumber)-4:]), nil
 }
 
 // PayPal type
@@ -160,20 +188,64 @@ type PayPal struct {
 	Email string
 }
 
-func (pp PayPal) Pay(amount float64) string {
-	return fmt.Sprintf("Paid $%.2f using PayPal account %s", amount, pp.Email)
+func (pp PayPal) Pay(amount float64) (string, error) {
+	if err := validateAmount(amount); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Paid $%.2f using PayPal account %s", amount, pp.Email), nil
 }
 
 // Cash type
 type Cash struct{}
 
-func (c Cash) Pay(amount float64) string {
-	return fmt.Sprintf("Paid $%.2f in cash", amount)
+func (c Cash) Pay(amount float64) (string, error) {
+	if err := validateAmount(amount); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Paid $%.2f in cash", amount), nil
 }
 
 // processPayment works with any payment method
 func processPayment(pm PaymentMethod, amount float64) {
-	fmt.Println(pm.Pay(amount))
+	message, err := pm.Pay(amount)
+	if err != nil {
+		fmt.Println("Payment failed:", err)
+		return
+	}
+	fmt.Println(message)
+}
+
+// Refundable is an optional extra behavior - not every PaymentMethod has it
+// Cash deliberately does not implement it
+type Refundable interface {
+	Refund(amount float64) string
+}
+
+func (cc CreditCard) Refund(amount float64) string {
+	return fmt.Sprintf("Refunded $%.2f to Credit Card ending in %s",
+		amount, cc.CardNumber[len(cc.CardNumber)-4:])
+}
+
+func (pp PayPal) Refund(amount float64) string {
+	return fmt.Sprintf("Refunded $%.2f to PayPal account %s", amount, pp.Email)
+}
+
+// refundMessage checks at runtime whether pm can also be refunded
+func refundMessage(pm PaymentMethod, amount float64) string {
+	// Type assertion to another interface: "does this value also have Refund?"
+	r, ok := pm.(Refundable)
+	if !ok {
+		return "refunds not supported"
+	}
+	if err := validateAmount(amount); err != nil {
+		return "refund failed: " + err.Error()
+	}
+	return r.Refund(amount)
+}
+
+// processRefund works with any payment method, refundable or not
+func processRefund(pm PaymentMethod, amount float64) {
+	fmt.Println(refundMessage(pm, amount))
 }
 
 // ============================================
@@ -201,12 +273,316 @@ func (ic *IntCounter) Value() int {
 	return ic.count
 }
 
+// ============================================
+// 11. 3D SHAPES: A SECOND INTERFACE
+// ============================================
+
+// Volume interface for solid (3D) shapes
+type Volume interface {
+	Volume() float64
+	SurfaceArea() float64
+}
+
+// Sphere type - implements Volume interface
+type Sphere struct {
+	Radius float64
+}
+
+// Volume method for Sphere: 4/3 * π * r³
+func (s Sphere) Volume() float64 {
+	return 4.0 / 3.0 * math.Pi * s.Radius * s.Radius * s.Radius
+}
+
+// SurfaceArea method for Sphere: 4 * π * r²
+func (s Sphere) SurfaceArea() float64 {
+	return 4 * math.Pi * s.Radius * s.Radius
+}
+
+// Cube type - also implements Volume interface
+type Cube struct {
+	Side float64
+}
+
+// Volume method for Cube
+func (c Cube) Volume() float64 {
+	return c.Side * c.Side * c.Side
+}
+
+// SurfaceArea method for Cube (6 square faces)
+func (c Cube) SurfaceArea() float64 {
+	return 6 * c.Side * c.Side
+}
+
+// printSolidInfo accepts any type that implements the Volume interface
+func printSolidInfo(v Volume) {
+	fmt.Printf("Volume: %.2f, Surface Area: %.2f\n", v.Volume(), v.SurfaceArea())
+}
+
+// ============================================
+// 12. WORKING WITH SLICES OF INTERFACES
+// ============================================
+
+// SortByArea sorts shapes in place from smallest to largest area
+// It only needs the Area method, so it works for any mix of shape types
+func SortByArea(shapes []Shape) {
+	sort.Slice(shapes, func(i, j int) bool {
+		return shapes[i].Area() < shapes[j].Area()
+	})
+}
+
+// ErrNoShapes is returned by AverageArea for an empty slice
+var ErrNoShapes = errors.New("no shapes")
+
+// TotalArea adds up the areas of all shapes (0 for an empty slice)
+func TotalArea(shapes []Shape) float64 {
+	total := 0.0
+	for _, shape := range shapes {
+		total += shape.Area()
+	}
+	return total
+}
+
+// AverageArea returns the mean area of the shapes
+// An empty slice has no average, so it returns ErrNoShapes instead of NaN
+func AverageArea(shapes []Shape) (float64, error) {
+	if len(shapes) == 0 {
+		return 0, ErrNoShapes
+	}
+	return TotalArea(shapes) / float64(len(shapes)), nil
+}
+
+// LargestShape returns the shape with the biggest area (nil for an empty slice)
+func LargestShape(shapes []Shape) Shape {
+	var largest Shape
+	for _, shape := range shapes {
+		if largest == nil || shape.Area() > largest.Area() {
+			largest = shape
+		}
+	}
+	return largest
+}
+
+// ============================================
+// 13. SCALING SHAPES (POINTER RECEIVERS)
+// ============================================
+
+// Scalable interface for shapes that can grow or shrink uniformly
+type Scalable interface {
+	Scale(factor float64)
+}
+
+// Scale must use a pointer receiver: with a value receiver the method
+// would change a copy of the Circle and the caller would see no change.
+// Because of this, only *Circle (not Circle) implements Scalable.
+func (c *Circle) Scale(factor float64) {
+	c.Radius *= factor
+}
+
+// Scale multiplies both dimensions of the rectangle by factor
+func (r *Rectangle) Scale(factor float64) {
+	r.Width *= factor
+	r.Height *= factor
+}
+
+// ============================================
+// 14. RECORDING PAYMENTS AS JSON
+// ============================================
+
+// PaymentRecord is a plain struct that can be saved with encoding/json
+// Interfaces can't be decoded from JSON directly, so the concrete
+// payment type is stored as a "method" string instead
+type PaymentRecord struct {
+	Method    string    `json:"method"`
+	Amount    float64   `json:"amount"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// RecordPayment captures which payment method was used and when
+func RecordPayment(pm PaymentMethod, amount float64) PaymentRecord {
+	return PaymentRecord{
+		Method:    paymentMethodName(pm),
+		Amount:    amount,
+		Timestamp: time.Now(),
+	}
+}
+
+// paymentMethodName turns the %T output (e.g. "main.CreditCard") into "CreditCard"
+func paymentMethodName(pm PaymentMethod) string {
+	typeName := fmt.Sprintf("%T", pm)
+	return typeName[strings.LastIndex(typeName, ".")+1:]
+}
+
+// ============================================
+// 15. SHAPE FACTORY (REGISTRY OF CONSTRUCTORS)
+// ============================================
+
+// Errors returned by ShapeFactory.Create
+var (
+	ErrUnknownShape  = errors.New("unknown shape type")
+	ErrInvalidParams = errors.New("invalid shape parameters")
+)
+
+// ShapeConstructor builds a Shape from named numeric parameters
+type ShapeConstructor func(params map[string]float64) (Shape, error)
+
+// ShapeFactory maps a type name like "circle" to its constructor
+// New shapes can be plugged in with Register without touching Create
+type ShapeFactory struct {
+	constructors map[string]ShapeConstructor
+}
+
+// NewShapeFactory returns a factory with "circle" and "rectangle" registered
+func NewShapeFactory() *ShapeFactory {
+	f := &ShapeFactory{constructors: make(map[string]ShapeConstructor)}
+	f.Register("circle", func(params map[string]float64) (Shape, error) {
+		radius, err := requireParam(params, "radius")
+		if err != nil {
+			return nil, err
+		}
+		return Circle{Radius: radius}, nil
+	})
+	f.Register("rectangle", func(params map[string]float64) (Shape, error) {
+		width, err := requireParam(params, "width")
+		if err != nil {
+			return nil, err
+		}
+		height, err := requireParam(params, "height")
+		if err != nil {
+			return nil, err
+		}
+		return Rectangle{Width: width, Height: height}, nil
+	})
+	return f
+}
+
+// Register adds (or replaces) the constructor for a shape type
+func (f *ShapeFactory) Register(typeName string, constructor ShapeConstructor) {
+	f.constructors[typeName] = constructor
+}
+
+// Create builds a shape of the given type from its parameters
+func (f *ShapeFactory) Create(typeName string, params map[string]float64) (Shape, error) {
+	constructor, ok := f.constructors[typeName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownShape, typeName)
+	}
+	return constructor(params)
+}
+
+// requireParam looks up a parameter that must be present and positive
+func requireParam(params map[string]float64, name string) (float64, error) {
+	value, ok := params[name]
+	if !ok || value <= 0 {
+		return 0, fmt.Errorf("%w: %q must be a positive number", ErrInvalidParams, name)
+	}
+	return value, nil
+}
+
+// ============================================
+// 16. READABLE PRINTING (fmt.Stringer)
+// ============================================
+
+// String makes Circle implement fmt.Stringer. The fmt package checks for
+// this interface, so fmt.Println(circle) prints "Circle(r=5.00)" instead
+// of the raw struct "{5}".
+func (c Circle) String() string {
+	return fmt.Sprintf("Circle(r=%.2f)", c.Radius)
+}
+
+// String makes Rectangle implement fmt.Stringer
+func (r Rectangle) String() string {
+	return fmt.Sprintf("Rectangle(w=%.2f, h=%.2f)", r.Width, r.Height)
+}
+
+// ============================================
+// 17. SAVING SHAPES AS JSON (TYPE TAGS)
+// ============================================
+
+// ShapeJSON is how one Shape is stored in JSON. encoding/json can't
+// decode into an interface, because it wouldn't know which concrete type
+// to create, so Type records it (the ShapeFactory name, "circle" or
+// "rectangle") and Data holds the shape's own fields until we know what to
+// build from them:
+//
+//	{"type": "circle", "data": {"radius": 5}}
+type ShapeJSON struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// MarshalShapes encodes shapes as a JSON array of ShapeJSON objects.
+// Circles and rectangles may be values or pointers (e.g. the *Circle a
+// Scalable holds); other types give ErrUnknownShape.
+func MarshalShapes(shapes []Shape) ([]byte, error) {
+	tagged := make([]ShapeJSON, 0, len(shapes))
+	for _, shape := range shapes {
+		typeName, err := shapeTypeName(shape)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := json.Marshal(shape) // The same JSON for a value or a pointer
+		if err != nil {
+			return nil, err
+		}
+		tagged = append(tagged, ShapeJSON{Type: typeName, Data: data})
+	}
+	return json.Marshal(tagged)
+}
+
+// shapeTypeName returns the ShapeFactory name for shape
+func shapeTypeName(shape Shape) (string, error) {
+	switch s := shape.(type) {
+	case Circle:
+		return "circle", nil
+	case *Circle:
+		if s != nil {
+			return "circle", nil
+		}
+	case Rectangle:
+		return "rectangle", nil
+	case *Rectangle:
+		if s != nil {
+			return "rectangle", nil
+		}
+	}
+	return "", fmt.Errorf("%w: %T", ErrUnknownShape, shape) // Includes nil pointers
+}
+
+// UnmarshalShapes decodes JSON written by MarshalShapes. Each object's data
+// is read as named numbers and handed to ShapeFactory.Create with its
+// "type", so any shape registered in the factory can be loaded and its
+// parameters are checked the same way. Shapes come back as values
+// (Circle, not *Circle), as the factory builds them.
+func UnmarshalShapes(data []byte) ([]Shape, error) {
+	var tagged []ShapeJSON
+	if err := json.Unmarshal(data, &tagged); err != nil {
+		return nil, err
+	}
+
+	factory := NewShapeFactory()
+	shapes := make([]Shape, 0, len(tagged))
+	for _, item := range tagged {
+		var params map[string]float64
+		if err := json.Unmarshal(item.Data, &params); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", item.Type, err)
+		}
+		shape, err := factory.Create(item.Type, params)
+		if err != nil {
+			return nil, err
+		}
+		shapes = append(shapes, shape)
+	}
+	return shapes, nil
+}
+
 // ============================================
 // MAIN FUNCTION - DEMONSTRATING ALL CONCEPTS
 // ============================================
 
 func main() {
-	fmt.Println("=== Go Interfaces Tutorial ===\n")
+	fmt.Println("=== Go Interfaces Tutorial ===")
+	fmt.Println()
 
 	// 1. Basic interface usage
 	fmt.Println("1. BASIC INTERFACE USAGE:")
@@ -225,13 +601,21 @@ func main() {
 		Circle{Radius: 7},
 	}
 
-	totalArea := 0.0
 	for i, shape := range shapes {
 		fmt.Printf("Shape %d: ", i+1)
 		printShapeInfo(shape)
-		totalArea += shape.Area()
 	}
-	fmt.Printf("Total area of all shapes: %.2f\n\n", totalArea)
+	fmt.Printf("Total area of all shapes: %.2f\n", TotalArea(shapes))
+	if average, err := AverageArea(shapes); err == nil {
+		fmt.Printf("Average area: %.2f\n", average)
+	}
+
+	SortByArea(shapes)
+	fmt.Println("Sorted by area:")
+	for _, shape := range shapes {
+		fmt.Printf("  %T with area %.2f\n", shape, shape.Area())
+	}
+	fmt.Printf("Largest shape: %v\n\n", LargestShape(shapes))
 
 	// 3. Interface composition
 	fmt.Println("3. INTERFACE COMPOSITION:")
@@ -261,13 +645,27 @@ func main() {
 
 	// 7. Practical example - payment processing
 	fmt.Println("7. PRACTICAL EXAMPLE - PAYMENT PROCESSING:")
-	creditCard := CreditCard{CardNumber: "1234567890123456", CardHolder: "John Doe"}
+	creditCard := CreditCard{CardNumber: "1234567890123456", CardHolder: "John Doe", Limit: 500}
 	paypal := PayPal{Email: "john@example.com"}
 	cash := Cash{}
 
 	processPayment(creditCard, 99.99)
 	processPayment(paypal, 49.50)
 	processPayment(cash, 25.00)
+	processPayment(creditCard, 750.00) // Over the card's limit
+	processPayment(cash, -10.00)       // Negative amounts are rejected
+
+	processRefund(creditCard, 19.99)
+	processRefund(paypal, 5.00)
+	processRefund(cash, 10.00)
+
+	record := RecordPayment(paypal, 49.50)
+	recordJSON, err := json.Marshal(record)
+	if err != nil {
+		fmt.Println("Error encoding payment record:", err)
+	} else {
+		fmt.Printf("Payment record: %s\n", recordJSON)
+	}
 	fmt.Println()
 
 	// 8. Interfaces with pointer receivers
@@ -278,6 +676,12 @@ func main() {
 	counter.Increment()
 	counter.Increment()
 	fmt.Printf("After 3 increments: %d\n", counter.Value())
+
+	// Scale only exists on *Circle / *Rectangle, so we pass pointers
+	bigCircle := Circle{Radius: 5}
+	var scalable Scalable = &bigCircle
+	scalable.Scale(2)
+	fmt.Printf("Circle after Scale(2): radius %.2f, area %.2f\n", bigCircle.Radius, bigCircle.Area())
 	fmt.Println()
 
 	// 9. Nil interface check
@@ -290,4 +694,61 @@ func main() {
 	if s != nil {
 		fmt.Printf("Interface s now holds a value: %v\n", s)
 	}
+	fmt.Println()
+
+	// 10. A second interface for 3D shapes
+	fmt.Println("10. 3D SHAPES (VOLUME INTERFACE):")
+	solids := []Volume{
+		Sphere{Radius: 1},
+		Cube{Side: 3},
+	}
+	for _, solid := range solids {
+		fmt.Printf("%T: ", solid)
+		printSolidInfo(solid)
+	}
+	fmt.Println()
+
+	// 11. Building shapes from data with a factory
+	fmt.Println("11. SHAPE FACTORY:")
+	factory := NewShapeFactory()
+	specs := []struct {
+		typeName string
+		params   map[string]float64
+	}{
+		{"circle", map[string]float64{"radius": 2}},
+		{"rectangle", map[string]float64{"width": 3, "height": 4}},
+		{"triangle", map[string]float64{"base": 3}},
+	}
+	for _, spec := range specs {
+		shape, err := factory.Create(spec.typeName, spec.params)
+		if err != nil {
+			fmt.Println("Error:", err)
+			continue
+		}
+		fmt.Printf("Created %T: ", shape)
+		printShapeInfo(shape)
+	}
+	fmt.Println()
+
+	// 12. fmt.Stringer
+	fmt.Println("12. READABLE PRINTING (fmt.Stringer):")
+	fmt.Println(circle)    // Uses Circle.String()
+	fmt.Println(rectangle) // Uses Rectangle.String()
+	fmt.Printf("As a Shape: %v\n", shapes[0])
+	fmt.Println()
+
+	// 13. Saving a []Shape as JSON
+	fmt.Println("13. SAVING SHAPES AS JSON:")
+	shapesJSON, err := MarshalShapes(shapes)
+	if err != nil {
+		fmt.Println("Error encoding shapes:", err)
+		return
+	}
+	fmt.Printf("JSON: %s\n", shapesJSON)
+	decoded, err := UnmarshalShapes(shapesJSON)
+	if err != nil {
+		fmt.Println("Error decoding shapes:", err)
+		return
+	}
+	fmt.Println("Decoded:", decoded)
 }
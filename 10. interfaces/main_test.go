@@ -0,0 +1,397 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// floatEquals compares floats with a small tolerance
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) < 1e-5
+}
+
+func TestSphereVolume(t *testing.T) {
+	var v Volume = Sphere{Radius: 1}
+
+	if !floatEquals(v.Volume(), 4.18879) {
+		t.Errorf("Sphere{1}.Volume() = %f; expected ~4.18879", v.Volume())
+	}
+	if !floatEquals(v.SurfaceArea(), 4*math.Pi) {
+		t.Errorf("Sphere{1}.SurfaceArea() = %f; expected ~%f", v.SurfaceArea(), 4*math.Pi)
+	}
+}
+
+func TestCubeVolume(t *testing.T) {
+	var v Volume = Cube{Side: 3}
+
+	if v.Volume() != 27 {
+		t.Errorf("Cube{3}.Volume() = %f; expected 27", v.Volume())
+	}
+	if v.SurfaceArea() != 54 {
+		t.Errorf("Cube{3}.SurfaceArea() = %f; expected 54", v.SurfaceArea())
+	}
+}
+
+func TestSortByArea(t *testing.T) {
+	shapes := []Shape{
+		Circle{Radius: 3},                // ~28.27
+		Rectangle{Width: 5, Height: 2},   // 10
+		Circle{Radius: 1},                // ~3.14
+		Rectangle{Width: 10, Height: 10}, // 100
+	}
+
+	SortByArea(shapes)
+
+	expected := []Shape{
+		Circle{Radius: 1},
+		Rectangle{Width: 5, Height: 2},
+		Circle{Radius: 3},
+		Rectangle{Width: 10, Height: 10},
+	}
+	for i := range expected {
+		if shapes[i] != expected[i] {
+			t.Errorf("shapes[%d] = %v; expected %v", i, shapes[i], expected[i])
+		}
+	}
+}
+
+func TestLargestShape(t *testing.T) {
+	shapes := []Shape{
+		Rectangle{Width: 4, Height: 6}, // 24
+		Circle{Radius: 3},              // ~28.27
+		Rectangle{Width: 5, Height: 2}, // 10
+	}
+
+	if got := LargestShape(shapes); got != (Circle{Radius: 3}) {
+		t.Errorf("LargestShape() = %v; expected Circle{3}", got)
+	}
+
+	if got := LargestShape(nil); got != nil {
+		t.Errorf("LargestShape(nil) = %v; expected nil", got)
+	}
+}
+
+func TestScaleCircle(t *testing.T) {
+	circle := Circle{Radius: 5}
+	originalArea := circle.Area()
+
+	var s Scalable = &circle
+	s.Scale(2)
+
+	if circle.Radius != 10 {
+		t.Errorf("Radius after Scale(2) = %f; expected 10", circle.Radius)
+	}
+	if !floatEquals(circle.Area(), 4*originalArea) {
+		t.Errorf("Area after Scale(2) = %f; expected 4x original (%f)", circle.Area(), 4*originalArea)
+	}
+}
+
+func TestScaleRectangle(t *testing.T) {
+	rect := Rectangle{Width: 4, Height: 6}
+
+	var s Scalable = &rect
+	s.Scale(0.5)
+
+	if rect.Width != 2 || rect.Height != 3 {
+		t.Errorf("Rectangle after Scale(0.5) = %+v; expected {Width:2 Height:3}", rect)
+	}
+}
+
+func TestRecordPaymentJSONRoundTrip(t *testing.T) {
+	card := CreditCard{CardNumber: "1234567890123456", CardHolder: "John Doe"}
+	record := RecordPayment(card, 99.99)
+
+	if record.Method != "CreditCard" {
+		t.Errorf("Method = %q; expected %q", record.Method, "CreditCard")
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if !strings.Contains(string(data), `"method":"CreditCard"`) {
+		t.Errorf("JSON %s is missing the method discriminator", data)
+	}
+
+	var decoded PaymentRecord
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if decoded.Method != record.Method || decoded.Amount != record.Amount {
+		t.Errorf("decoded = %+v; expected %+v", decoded, record)
+	}
+	// Equal ignores the monotonic clock reading, which JSON doesn't keep
+	if !decoded.Timestamp.Equal(record.Timestamp) {
+		t.Errorf("Timestamp = %v; expected %v", decoded.Timestamp, record.Timestamp)
+	}
+}
+
+func TestProcessRefund(t *testing.T) {
+	tests := []struct {
+		name     string
+		pm       PaymentMethod
+		amount   float64
+		expected string
+	}{
+		{"credit card", CreditCard{CardNumber: "1234567890123456"}, 10, "Refunded $10.00 to Credit Card ending in 3456"},
+		{"paypal", PayPal{Email: "john@example.com"}, 10, "Refunded $10.00 to PayPal account john@example.com"},
+		{"cash is not refundable", Cash{}, 10, "refunds not supported"},
+		{"negative refund", CreditCard{CardNumber: "1234567890123456"}, -10, "refund failed: invalid payment amount: -10.00"},
+		{"NaN refund", PayPal{Email: "john@example.com"}, math.NaN(), "refund failed: invalid payment amount: NaN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := refundMessage(tt.pm, tt.amount); got != tt.expected {
+				t.Errorf("refundMessage() = %q; expected %q", got, tt.expected)
+			}
+		})
+	}
+
+	if _, ok := PaymentMethod(Cash{}).(Refundable); ok {
+		t.Error("Cash should not implement Refundable")
+	}
+}
+
+func TestPayNegativeAmount(t *testing.T) {
+	methods := []PaymentMethod{
+		CreditCard{CardNumber: "1234567890123456"},
+		PayPal{Email: "john@example.com"},
+		Cash{},
+	}
+
+	for _, pm := range methods {
+		message, err := pm.Pay(-5)
+		if !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("%T.Pay(-5) error = %v; expected ErrInvalidAmount", pm, err)
+		}
+		if message != "" {
+			t.Errorf("%T.Pay(-5) message = %q; expected empty", pm, message)
+		}
+	}
+}
+
+func TestPayNaNAmount(t *testing.T) {
+	methods := []PaymentMethod{
+		CreditCard{CardNumber: "1234567890123456"},
+		CreditCard{CardNumber: "1234567890123456", Limit: 100},
+		PayPal{Email: "john@example.com"},
+		Cash{},
+	}
+
+	for _, pm := range methods {
+		message, err := pm.Pay(math.NaN())
+		if !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("%T.Pay(NaN) error = %v; expected ErrInvalidAmount", pm, err)
+		}
+		if message != "" {
+			t.Errorf("%T.Pay(NaN) message = %q; expected empty", pm, message)
+		}
+	}
+}
+
+func TestCreditCardOverLimit(t *testing.T) {
+	card := CreditCard{CardNumber: "1234567890123456", Limit: 500}
+
+	if _, err := card.Pay(750); !errors.Is(err, ErrOverLimit) {
+		t.Errorf("Pay(750) with limit 500: error = %v; expected ErrOverLimit", err)
+	}
+
+	message, err := card.Pay(500)
+	if err != nil {
+		t.Fatalf("Pay(500) with limit 500 returned error: %v", err)
+	}
+	if message != "Paid $500.00 using Credit Card ending in 3456" {
+		t.Errorf("Pay(500) = %q", message)
+	}
+}
+
+func TestShapeFactoryCreate(t *testing.T) {
+	factory := NewShapeFactory()
+
+	circle, err := factory.Create("circle", map[string]float64{"radius": 2})
+	if err != nil {
+		t.Fatalf("Create(circle) returned error: %v", err)
+	}
+	if circle != (Circle{Radius: 2}) {
+		t.Errorf("Create(circle) = %v; expected Circle{Radius: 2}", circle)
+	}
+
+	rect, err := factory.Create("rectangle", map[string]float64{"width": 3, "height": 4})
+	if err != nil {
+		t.Fatalf("Create(rectangle) returned error: %v", err)
+	}
+	if rect.Area() != 12 {
+		t.Errorf("rectangle area = %f; expected 12", rect.Area())
+	}
+}
+
+func TestShapeFactoryErrors(t *testing.T) {
+	factory := NewShapeFactory()
+
+	if _, err := factory.Create("triangle", nil); !errors.Is(err, ErrUnknownShape) {
+		t.Errorf("Create(triangle) error = %v; expected ErrUnknownShape", err)
+	}
+	if _, err := factory.Create("rectangle", map[string]float64{"width": 3}); !errors.Is(err, ErrInvalidParams) {
+		t.Errorf("Create(rectangle without height) error = %v; expected ErrInvalidParams", err)
+	}
+}
+
+func TestShapeFactoryRegister(t *testing.T) {
+	factory := NewShapeFactory()
+	factory.Register("square", func(params map[string]float64) (Shape, error) {
+		side, err := requireParam(params, "side")
+		if err != nil {
+			return nil, err
+		}
+		return Rectangle{Width: side, Height: side}, nil
+	})
+
+	square, err := factory.Create("square", map[string]float64{"side": 5})
+	if err != nil {
+		t.Fatalf("Create(square) returned error: %v", err)
+	}
+	if square.Area() != 25 {
+		t.Errorf("square area = %f; expected 25", square.Area())
+	}
+}
+
+func TestShapeString(t *testing.T) {
+	tests := []struct {
+		shape    Shape
+		expected string
+	}{
+		{Circle{Radius: 5}, "Circle(r=5.00)"},
+		{Circle{Radius: 1.234}, "Circle(r=1.23)"},
+		{Rectangle{Width: 4, Height: 6}, "Rectangle(w=4.00, h=6.00)"},
+		{Rectangle{Width: 2.5, Height: 0.5}, "Rectangle(w=2.50, h=0.50)"},
+	}
+
+	for _, tt := range tests {
+		// fmt uses String() even when the value is stored in a Shape
+		if got := fmt.Sprint(tt.shape); got != tt.expected {
+			t.Errorf("fmt.Sprint(%#v) = %q; expected %q", tt.shape, got, tt.expected)
+		}
+	}
+}
+
+func TestShapesJSONRoundTrip(t *testing.T) {
+	shapes := []Shape{
+		Circle{Radius: 3},
+		Rectangle{Width: 5, Height: 2},
+		Circle{Radius: 0.5},
+	}
+
+	data, err := MarshalShapes(shapes)
+	if err != nil {
+		t.Fatalf("MarshalShapes returned error: %v", err)
+	}
+	decoded, err := UnmarshalShapes(data)
+	if err != nil {
+		t.Fatalf("UnmarshalShapes returned error: %v", err)
+	}
+
+	// Same concrete types, same values, same order
+	if !reflect.DeepEqual(decoded, shapes) {
+		t.Errorf("round trip = %v; expected %v", decoded, shapes)
+	}
+
+	expectedJSON := `[{"type":"circle","data":{"radius":3}},` +
+		`{"type":"rectangle","data":{"width":5,"height":2}},` +
+		`{"type":"circle","data":{"radius":0.5}}]`
+	if string(data) != expectedJSON {
+		t.Errorf("MarshalShapes = %s; expected %s", data, expectedJSON)
+	}
+}
+
+func TestShapesJSONRoundTripPointers(t *testing.T) {
+	circle := &Circle{Radius: 2}
+	rectangle := &Rectangle{Width: 4, Height: 3}
+	var scalable Scalable = circle // Only the pointer satisfies Scalable
+	scalable.Scale(2)
+
+	data, err := MarshalShapes([]Shape{circle, rectangle, Circle{Radius: 1}})
+	if err != nil {
+		t.Fatalf("MarshalShapes(pointers) returned error: %v", err)
+	}
+	decoded, err := UnmarshalShapes(data)
+	if err != nil {
+		t.Fatalf("UnmarshalShapes returned error: %v", err)
+	}
+
+	// The factory builds values, so pointers come back as the values they pointed to
+	expected := []Shape{Circle{Radius: 4}, Rectangle{Width: 4, Height: 3}, Circle{Radius: 1}}
+	if !reflect.DeepEqual(decoded, expected) {
+		t.Errorf("round trip = %v; expected %v", decoded, expected)
+	}
+}
+
+func TestShapesJSONErrors(t *testing.T) {
+	if _, err := MarshalShapes([]Shape{(*Circle)(nil)}); !errors.Is(err, ErrUnknownShape) {
+		t.Errorf("MarshalShapes(nil *Circle) error = %v; expected ErrUnknownShape", err)
+	}
+
+	_, err := UnmarshalShapes([]byte(`[{"type":"triangle","data":{}}]`))
+	if !errors.Is(err, ErrUnknownShape) {
+		t.Errorf("UnmarshalShapes(triangle) error = %v; expected ErrUnknownShape", err)
+	}
+
+	_, err = UnmarshalShapes([]byte(`[{"type":"circle","data":{"radius":"big"}}]`))
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Errorf("UnmarshalShapes(bad radius) error = %v; expected *json.UnmarshalTypeError", err)
+	}
+
+	// Data goes through ShapeFactory.Create, which checks the parameters
+	_, err = UnmarshalShapes([]byte(`[{"type":"circle","data":{"radius":-1}}]`))
+	if !errors.Is(err, ErrInvalidParams) {
+		t.Errorf("UnmarshalShapes(negative radius) error = %v; expected ErrInvalidParams", err)
+	}
+
+	if _, err := UnmarshalShapes([]byte(`not json`)); err == nil {
+		t.Error("UnmarshalShapes(not json) returned nil error")
+	}
+}
+
+func TestTotalAndAverageArea(t *testing.T) {
+	tests := []struct {
+		name            string
+		shapes          []Shape
+		expectedTotal   float64
+		expectedAverage float64
+	}{
+		{"single rectangle", []Shape{Rectangle{Width: 4, Height: 6}}, 24, 24},
+		{"rectangles", []Shape{Rectangle{Width: 2, Height: 3}, Rectangle{Width: 10, Height: 1}}, 16, 8},
+		{"mixed", []Shape{Circle{Radius: 1}, Rectangle{Width: 5, Height: 2}}, math.Pi + 10, (math.Pi + 10) / 2},
+		{"unit circles", []Shape{Circle{Radius: 1}, Circle{Radius: 1}, Circle{Radius: 1}}, 3 * math.Pi, math.Pi},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TotalArea(tt.shapes); !floatEquals(got, tt.expectedTotal) {
+				t.Errorf("TotalArea() = %f; expected %f", got, tt.expectedTotal)
+			}
+			got, err := AverageArea(tt.shapes)
+			if err != nil {
+				t.Fatalf("AverageArea() returned error: %v", err)
+			}
+			if !floatEquals(got, tt.expectedAverage) {
+				t.Errorf("AverageArea() = %f; expected %f", got, tt.expectedAverage)
+			}
+		})
+	}
+}
+
+func TestAreaOfNoShapes(t *testing.T) {
+	if got := TotalArea(nil); got != 0 {
+		t.Errorf("TotalArea(nil) = %f; expected 0", got)
+	}
+	if _, err := AverageArea([]Shape{}); !errors.Is(err, ErrNoShapes) {
+		t.Errorf("AverageArea(empty) error = %v; expected ErrNoShapes", err)
+	}
+}
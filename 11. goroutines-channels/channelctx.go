@@ -0,0 +1,39 @@
+package main
+
+import "context"
+
+// SumChannel adds up every value received from in until the channel is
+// closed or ctx is cancelled. On cancellation it returns the sum so far
+// together with ctx.Err(), so the caller knows the total is partial.
+func SumChannel(ctx context.Context, in <-chan int) (int, error) {
+	sum := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return sum, ctx.Err()
+		case value, ok := <-in:
+			if !ok {
+				return sum, nil // Channel closed - we saw every value
+			}
+			sum += value
+		}
+	}
+}
+
+// DrainCtx collects every value from ch until the channel is closed or ctx
+// is cancelled, and returns what it collected. After a cancellation the
+// result is partial; check ctx.Err() if you need to know which happened.
+func DrainCtx[T any](ctx context.Context, ch <-chan T) []T {
+	var collected []T
+	for {
+		select {
+		case <-ctx.Done():
+			return collected
+		case value, ok := <-ch:
+			if !ok {
+				return collected
+			}
+			collected = append(collected, value)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSumChannel(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		for i := 1; i <= 5; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+
+	sum, err := SumChannel(context.Background(), in)
+	if err != nil {
+		t.Fatalf("SumChannel returned error: %v", err)
+	}
+	if sum != 15 {
+		t.Errorf("SumChannel = %d; expected 15", sum)
+	}
+}
+
+func TestSumChannelCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int) // Never closed
+
+	type result struct {
+		sum int
+		err error
+	}
+	done := make(chan result)
+	go func() {
+		sum, err := SumChannel(ctx, in)
+		done <- result{sum, err}
+	}()
+
+	// Unbuffered sends only finish once SumChannel has received the value
+	in <- 1
+	in <- 2
+	in <- 3
+	cancel()
+
+	r := <-done
+	if !errors.Is(r.err, context.Canceled) {
+		t.Errorf("error = %v; expected context.Canceled", r.err)
+	}
+	if r.sum != 6 {
+		t.Errorf("partial sum = %d; expected 6", r.sum)
+	}
+}
+
+func TestDrainCtx(t *testing.T) {
+	ch := make(chan string, 3)
+	ch <- "a"
+	ch <- "b"
+	ch <- "c"
+	close(ch)
+
+	got := DrainCtx(context.Background(), ch)
+
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("DrainCtx = %v; expected [a b c]", got)
+	}
+}
+
+func TestDrainCtxCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int) // Never closed
+
+	done := make(chan []int)
+	go func() {
+		done <- DrainCtx(ctx, ch)
+	}()
+
+	ch <- 1
+	ch <- 2
+	cancel()
+
+	got := <-done
+	if !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("DrainCtx after cancel = %v; expected partial [1 2]", got)
+	}
+}
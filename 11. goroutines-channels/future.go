@@ -0,0 +1,30 @@
+package main
+
+// Future is a value that is still being computed in another goroutine.
+// Start one with Async and collect the result later with Await.
+type Future[T any] struct {
+	done  chan struct{} // Closed once value and err are set
+	value T
+	err   error
+}
+
+// Async starts fn in a new goroutine and returns straight away, so the
+// caller can do other work while fn runs
+func Async[T any](fn func() (T, error)) *Future[T] {
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		f.value, f.err = fn()
+		close(f.done)
+	}()
+	return f
+}
+
+// Await blocks until fn has finished and returns its result. It can be
+// called any number of times, from any goroutine: fn runs only once and
+// every call gets the same result.
+// A closed channel never blocks, and closing it happens after value and
+// err are written, so reading them afterwards is safe without a mutex.
+func (f *Future[T]) Await() (T, error) {
+	<-f.done
+	return f.value, f.err
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFutureComputesOnce(t *testing.T) {
+	var calls atomic.Int32
+	future := Async(func() (int, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond) // Make the Await calls below really wait
+		return 42, nil
+	})
+
+	// Await from several goroutines at once, then once more afterwards
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value, err := future.Await(); value != 42 || err != nil {
+				t.Errorf("Await() = %d, %v; expected 42, nil", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if value, err := future.Await(); value != 42 || err != nil {
+		t.Errorf("Await() again = %d, %v; expected 42, nil", value, err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fn called %d times; expected 1", got)
+	}
+}
+
+func TestFutureReturnsError(t *testing.T) {
+	errFailed := errors.New("failed")
+	future := Async(func() (string, error) {
+		return "", errFailed
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := future.Await(); !errors.Is(err, errFailed) {
+			t.Errorf("Await() error = %v; expected %v", err, errFailed)
+		}
+	}
+}
+
+func TestFutureRunsConcurrently(t *testing.T) {
+	// Each future reports that it started, then waits for release. Both
+	// starts can only arrive if the futures run at the same time: run one
+	// after the other, the first would block on release forever.
+	started := make(chan int, 2)
+	release := make(chan struct{})
+	task := func(n int) func() (int, error) {
+		return func() (int, error) {
+			started <- n
+			<-release
+			return n, nil
+		}
+	}
+
+	a := Async(task(1))
+	b := Async(task(2))
+
+	for range 2 {
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second): // Only to fail instead of hanging
+			close(release)
+			t.Fatal("only one future started; expected both to run at once")
+		}
+	}
+	close(release)
+
+	x, _ := a.Await()
+	y, _ := b.Await()
+	if x+y != 3 {
+		t.Errorf("a + b = %d; expected 3", x+y)
+	}
+}
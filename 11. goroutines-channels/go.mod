@@ -0,0 +1,8 @@
+module goroutines-channels
+
+go 1.23.0
+
+require examples v0.0.0
+
+// examples is the shared demo runner in the repository root
+replace examples => ../examples
@@ -1,19 +1,26 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"sync"
 	"time"
+
+	"examples"
 )
 
 // Basic goroutine example
-func printNumbers() {
+func printNumbers(wg *sync.WaitGroup) {
+	defer wg.Done() // Tell the WaitGroup we're finished, even if we return early
 	for i := 1; i <= 5; i++ {
 		fmt.Printf("Number: %d\n", i)
 		time.Sleep(100 * time.Millisecond)
 	}
 }
 
-func printLetters() {
+func printLetters(wg *sync.WaitGroup) {
+	defer wg.Done()
 	letters := []string{"A", "B", "C", "D", "E"}
 	for _, letter := range letters {
 		fmt.Printf("Letter: %s\n", letter)
@@ -37,6 +44,28 @@ func receiveData(ch chan int) {
 	}
 }
 
+// Example 1: Basic goroutines
+func basicGoroutinesExample() {
+	fmt.Println("--- Basic Goroutines ---")
+	// Sleeping "long enough" is a guess: printLetters needs ~750ms, so a
+	// shorter sleep cuts it off, and a longer one wastes time. On a busy
+	// machine no fixed duration is safe. A WaitGroup waits exactly as long
+	// as the goroutines actually take.
+	var wg sync.WaitGroup
+	wg.Add(2) // Two goroutines to wait for
+	go printNumbers(&wg)
+	go printLetters(&wg)
+	wg.Wait() // Blocks until both have called wg.Done()
+}
+
+// Example 2: Channels
+func channelExample() {
+	fmt.Println("\n--- Channels ---")
+	ch := make(chan int)
+	go sendData(ch)
+	receiveData(ch)
+}
+
 // Buffered channel example
 func bufferedChannelExample() {
 	fmt.Println("\n--- Buffered Channel Example ---")
@@ -85,53 +114,230 @@ func workerPoolExample() {
 // Select statement example
 func selectExample() {
 	fmt.Println("\n--- Select Statement Example ---")
-	ch1 := make(chan string)
-	ch2 := make(chan string)
+	messages, timedOut := receiveWithTimeout(100*time.Millisecond, 200*time.Millisecond, 150*time.Millisecond)
+	for _, msg := range messages {
+		fmt.Println(msg)
+	}
+	if timedOut {
+		fmt.Println("timed out waiting")
+	}
+}
+
+// receiveWithTimeout waits for a message from two channels that reply after
+// delay1 and delay2, but gives up once timeout has passed. It returns the
+// messages that arrived in time and whether the timeout fired.
+func receiveWithTimeout(delay1, delay2, timeout time.Duration) (messages []string, timedOut bool) {
+	// Buffered so a late sender doesn't block forever after we stop listening
+	ch1 := make(chan string, 1)
+	ch2 := make(chan string, 1)
 
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		time.Sleep(delay1)
 		ch1 <- "Message from channel 1"
 	}()
 
 	go func() {
-		time.Sleep(200 * time.Millisecond)
+		time.Sleep(delay2)
 		ch2 <- "Message from channel 2"
 	}()
 
+	// Create the timer once, outside the loop: time.After inside the select
+	// would start a fresh 150ms timer on every iteration
+	deadline := time.After(timeout)
+
 	for i := 0; i < 2; i++ {
 		select {
 		case msg1 := <-ch1:
-			fmt.Println(msg1)
+			messages = append(messages, msg1)
 		case msg2 := <-ch2:
-			fmt.Println(msg2)
+			messages = append(messages, msg2)
+		case <-deadline:
+			return messages, true
 		}
 	}
+	return messages, false
+}
+
+// Context cancellation example. ctx comes from the demo runner and is
+// cancelled on Ctrl-C, which stops both sums early.
+func sumChannelExample(ctx context.Context) {
+	fmt.Println("\n--- Summing a Channel with Context ---")
+	numbers := make(chan int)
+	go func() {
+		for i := 1; i <= 10; i++ {
+			numbers <- i
+		}
+		close(numbers)
+	}()
+
+	sum, err := SumChannel(ctx, numbers)
+	fmt.Printf("Sum of 1..10: %d (err: %v)\n", sum, err)
+
+	// A context with a timeout stops the sum early if values arrive too slowly
+	ctx, cancel := context.WithTimeout(ctx, 250*time.Millisecond)
+	defer cancel()
+	slow := make(chan int)
+	go func() {
+		defer close(slow)
+		for i := 1; i <= 10; i++ {
+			select {
+			case slow <- i:
+			case <-ctx.Done():
+				return // Stop producing so this goroutine doesn't leak
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}()
+
+	sum, err = SumChannel(ctx, slow)
+	fmt.Printf("Partial sum before timeout: %d (err: %v)\n", sum, err)
+}
+
+// Generator -> Square pipeline example
+func generatorExample() {
+	fmt.Println("\n--- Generator and Square Pipeline ---")
+	// Each stage runs in its own goroutine; values flow through as they're ready
+	for n := range Square(Generator(2, 3, 4)) {
+		fmt.Println(n)
+	}
+}
+
+// Rate limiting example
+func rateLimitExample() {
+	fmt.Println("\n--- Rate Limiting with a Ticker ---")
+	jobs := make(chan int, 3)
+	for i := 1; i <= 3; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	start := time.Now()
+	for job := range RateLimited(jobs, 10) { // At most 10 jobs per second
+		fmt.Printf("Job %d at %v\n", job, time.Since(start).Round(10*time.Millisecond))
+	}
+}
+
+// Semaphore example
+func semaphoreExample() {
+	fmt.Println("\n--- Limiting Concurrency with a Semaphore ---")
+	sem := NewSemaphore(2) // At most 2 workers at a time
+	var wg sync.WaitGroup
+
+	for job := 1; job <= 6; job++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem.Acquire()
+			defer sem.Release() // Always give the slot back, or later jobs wait forever
+
+			fmt.Printf("Processing job %d\n", job)
+			time.Sleep(50 * time.Millisecond)
+		}()
+	}
+	wg.Wait()
+}
+
+// Pipeline example
+func pipelineExample() {
+	fmt.Println("\n--- Pipeline Stages ---")
+	square := MapStage(func(n int) int { return n * n })
+	describe := MapStage(func(n int) string { return fmt.Sprintf("square is %d", n) })
+	pipeline := Then(square, describe)
+
+	in := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+
+	for result := range pipeline(in) {
+		fmt.Println(result)
+	}
+}
+
+// Object pool example
+func poolExample() {
+	fmt.Println("\n--- Reusing Objects with a Pool ---")
+	created := 0
+	pool := NewPool(2, func() *bytes.Buffer {
+		created++
+		return new(bytes.Buffer)
+	})
+
+	for i := 1; i <= 5; i++ {
+		buf := pool.Get()
+		fmt.Fprintf(buf, "request %d", i)
+		fmt.Println("Handled", buf.String())
+		buf.Reset() // Clear it before the next user gets it
+		pool.Put(buf)
+	}
+	fmt.Printf("5 requests needed only %d buffer(s)\n", created)
+}
+
+// Future example
+func futureExample() {
+	fmt.Println("\n--- Futures with Async and Await ---")
+	start := time.Now()
+	slowSquare := func(n int) func() (int, error) {
+		return func() (int, error) {
+			time.Sleep(100 * time.Millisecond) // Pretend this is slow work
+			return n * n, nil
+		}
+	}
+
+	// Both start straight away and run at the same time
+	a := Async(slowSquare(3))
+	b := Async(slowSquare(4))
+
+	x, _ := a.Await()
+	y, _ := b.Await()
+	fmt.Printf("3² + 4² = %d (took %v, not 200ms)\n", x+y, time.Since(start).Round(10*time.Millisecond))
 }
 
 func main() {
 	fmt.Println("=== Goroutines and Channels ===")
 	fmt.Println()
 
+	demos := examples.NewRegistry()
+
 	// Example 1: Basic goroutines
-	fmt.Println("--- Basic Goroutines ---")
-	go printNumbers()
-	go printLetters()
-	time.Sleep(1 * time.Second) // Wait for goroutines to finish
+	demos.Register("goroutines/basic", examples.Plain(basicGoroutinesExample))
 
 	// Example 2: Channels
-	fmt.Println("\n--- Channels ---")
-	ch := make(chan int)
-	go sendData(ch)
-	receiveData(ch)
+	demos.Register("goroutines/channels", examples.Plain(channelExample))
 
 	// Example 3: Buffered channels
-	bufferedChannelExample()
+	demos.Register("goroutines/buffered", examples.Plain(bufferedChannelExample))
 
 	// Example 4: Worker pool
-	workerPoolExample()
+	demos.Register("goroutines/workers", examples.Plain(workerPoolExample))
 
 	// Example 5: Select statement
-	selectExample()
+	demos.Register("goroutines/select", examples.Plain(selectExample))
+
+	// Example 6: Stopping early with a context (Ctrl-C stops it too)
+	demos.Register("goroutines/context", sumChannelExample)
+
+	// Example 7: Composing pipeline stages
+	demos.Register("goroutines/pipeline", examples.Plain(pipelineExample))
+
+	// Example 8: A simple generator pipeline
+	demos.Register("goroutines/generator", examples.Plain(generatorExample))
+
+	// Example 9: Throttling with a ticker
+	demos.Register("goroutines/ratelimit", examples.Plain(rateLimitExample))
+
+	// Example 10: Bounding concurrency with a semaphore
+	demos.Register("goroutines/semaphore", examples.Plain(semaphoreExample))
+
+	// Example 11: Reusing objects with a pool
+	demos.Register("goroutines/pool", examples.Plain(poolExample))
+
+	// Example 12: Waiting for results with futures
+	demos.Register("goroutines/future", examples.Plain(futureExample))
 
-	fmt.Println("\nAll examples completed!")
+	// Runs everything, or just what -demo picks (e.g. -demo goroutines/select)
+	examples.Main(demos)
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReceiveWithTimeout(t *testing.T) {
+	tests := []struct {
+		name             string
+		delay1, delay2   time.Duration
+		timeout          time.Duration
+		expectedMessages []string
+		expectedTimedOut bool
+	}{
+		{
+			name:             "both arrive in time",
+			delay1:           10 * time.Millisecond,
+			delay2:           20 * time.Millisecond,
+			timeout:          time.Second,
+			expectedMessages: []string{"Message from channel 1", "Message from channel 2"},
+		},
+		{
+			name:             "channel 2 too slow",
+			delay1:           10 * time.Millisecond,
+			delay2:           time.Second,
+			timeout:          100 * time.Millisecond,
+			expectedMessages: []string{"Message from channel 1"},
+			expectedTimedOut: true,
+		},
+		{
+			name:             "nothing arrives",
+			delay1:           time.Second,
+			delay2:           time.Second,
+			timeout:          10 * time.Millisecond,
+			expectedTimedOut: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages, timedOut := receiveWithTimeout(tt.delay1, tt.delay2, tt.timeout)
+
+			if timedOut != tt.expectedTimedOut {
+				t.Errorf("timedOut = %v; expected %v", timedOut, tt.expectedTimedOut)
+			}
+			if !reflect.DeepEqual(messages, tt.expectedMessages) {
+				t.Errorf("messages = %v; expected %v", messages, tt.expectedMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+// Generator is the first stage of a pipeline: it sends nums on a channel
+// from its own goroutine and closes the channel after the last one
+func Generator(nums ...int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for _, n := range nums {
+			out <- n
+		}
+	}()
+	return out
+}
+
+// Square reads numbers from in and sends their squares, closing its output
+// once in is closed. It has the shape of a Stage[int, int].
+func Square(in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := range in {
+			out <- n * n
+		}
+	}()
+	return out
+}
+
+// Stage is one step of a pipeline: it reads values of type I from a channel
+// and returns a channel of O values. A stage must close its output channel
+// once its input is closed, so the next stage's range loop can finish.
+type Stage[I, O any] func(in <-chan I) <-chan O
+
+// Then connects two stages: the output of first becomes the input of second.
+// It is a function rather than a method because Go methods can't introduce
+// new type parameters (M and O here).
+func Then[I, M, O any](first Stage[I, M], second Stage[M, O]) Stage[I, O] {
+	return func(in <-chan I) <-chan O {
+		return second(first(in))
+	}
+}
+
+// MapStage turns a plain function into a Stage that applies it to every value
+func MapStage[I, O any](f func(I) O) Stage[I, O] {
+	return func(in <-chan I) <-chan O {
+		out := make(chan O)
+		go func() {
+			defer close(out) // Tell the next stage we're done
+			for value := range in {
+				out <- f(value)
+			}
+		}()
+		return out
+	}
+}
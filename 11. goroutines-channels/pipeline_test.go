@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// sendAll returns a closed channel holding the given values
+func sendAll[T any](values ...T) <-chan T {
+	ch := make(chan T, len(values))
+	for _, v := range values {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func TestGeneratorSquare(t *testing.T) {
+	var got []int
+	for n := range Square(Generator(2, 3, 4)) {
+		got = append(got, n)
+	}
+
+	expected := []int{4, 9, 16}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Square(Generator(2, 3, 4)) = %v; expected %v", got, expected)
+	}
+}
+
+func TestThenComposesStages(t *testing.T) {
+	square := MapStage(func(n int) int { return n * n })
+	label := MapStage(func(n int) string { return fmt.Sprintf("#%d", n) })
+
+	pipeline := Then(square, label)
+
+	var got []string
+	for value := range pipeline(sendAll(1, 2, 3)) { // Ends only if every stage closes its output
+		got = append(got, value)
+	}
+
+	expected := []string{"#1", "#4", "#9"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("pipeline output = %v; expected %v", got, expected)
+	}
+}
+
+func TestThenChained(t *testing.T) {
+	double := MapStage(func(n int) int { return n * 2 })
+	addOne := MapStage(func(n int) int { return n + 1 })
+
+	pipeline := Then(Then(double, addOne), double)
+
+	var got []int
+	for value := range pipeline(sendAll(1, 2, 3)) {
+		got = append(got, value)
+	}
+
+	expected := []int{6, 10, 14} // (n*2+1)*2
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("pipeline output = %v; expected %v", got, expected)
+	}
+}
+
+func TestStageClosesOnEmptyInput(t *testing.T) {
+	out := MapStage(func(n int) int { return n })(sendAll[int]())
+
+	if _, ok := <-out; ok {
+		t.Error("expected the output channel to be closed for empty input")
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+// Pool keeps up to a fixed number of idle items (buffers, connections...)
+// so they can be reused instead of built from scratch every time.
+// The idle items sit in a buffered channel, which makes Get and Put safe to
+// call from many goroutines at once.
+type Pool[T any] struct {
+	items   chan T
+	factory func() T
+}
+
+// NewPool creates a pool that keeps at most size idle items and calls
+// factory to build a new one whenever Get finds the pool empty
+func NewPool[T any](size int, factory func() T) *Pool[T] {
+	return &Pool[T]{
+		items:   make(chan T, size),
+		factory: factory,
+	}
+}
+
+// Get returns an idle item, or a new one from the factory if none are free.
+// It never blocks.
+func (p *Pool[T]) Get() T {
+	select {
+	case item := <-p.items:
+		return item
+	default: // Nothing idle right now
+		return p.factory()
+	}
+}
+
+// Put hands an item back so a later Get can reuse it. Reset the item first
+// if it holds state (e.g. call Reset on a bytes.Buffer). If the pool already
+// holds size idle items, the extra one is dropped for the garbage collector.
+func (p *Pool[T]) Put(item T) {
+	select {
+	case p.items <- item:
+	default: // Pool is full
+	}
+}
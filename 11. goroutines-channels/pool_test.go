@@ -0,0 +1,89 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPoolReusesItems(t *testing.T) {
+	created := 0
+	pool := NewPool(1, func() *int {
+		created++
+		n := created
+		return &n
+	})
+
+	first := pool.Get()
+	pool.Put(first)
+	second := pool.Get()
+
+	if second != first {
+		t.Errorf("Get after Put returned %p; expected the returned item %p", second, first)
+	}
+	if created != 1 {
+		t.Errorf("factory called %d times; expected 1", created)
+	}
+}
+
+func TestPoolCreatesWhenEmpty(t *testing.T) {
+	created := 0
+	pool := NewPool(2, func() int {
+		created++
+		return created
+	})
+
+	// Nothing has been put back, so every Get needs a new item
+	a, b := pool.Get(), pool.Get()
+
+	if a == b || created != 2 {
+		t.Errorf("Get, Get = %d, %d with %d items created; expected two new items", a, b, created)
+	}
+}
+
+func TestPoolDropsItemsWhenFull(t *testing.T) {
+	pool := NewPool(1, func() string { return "new" })
+
+	pool.Put("kept")
+	pool.Put("dropped") // Doesn't block, even though the pool is full
+
+	if got := pool.Get(); got != "kept" {
+		t.Errorf("first Get = %q; expected %q", got, "kept")
+	}
+	if got := pool.Get(); got != "new" {
+		t.Errorf("second Get = %q; expected %q from the factory", got, "new")
+	}
+}
+
+func TestPoolConcurrentBorrows(t *testing.T) {
+	const limit = 4
+	const borrows = 100
+
+	var created atomic.Int32
+	pool := NewPool(limit, func() []byte {
+		created.Add(1)
+		return make([]byte, 1024)
+	})
+
+	// The semaphore allows at most limit items out at once. The pool only
+	// builds an item when none are idle, so it never needs more than limit.
+	sem := NewSemaphore(limit)
+	var wg sync.WaitGroup
+	for i := 0; i < borrows; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem.Acquire()
+			defer sem.Release()
+
+			buf := pool.Get()
+			buf[0] = byte(i)
+			pool.Put(buf)
+		}()
+	}
+	wg.Wait()
+
+	if got := created.Load(); got > limit {
+		t.Errorf("factory called %d times for %d borrows; expected at most %d", got, borrows, limit)
+	}
+}
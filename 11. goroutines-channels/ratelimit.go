@@ -0,0 +1,25 @@
+package main
+
+import "time"
+
+// RateLimited forwards every job from jobs to the returned channel, but no
+// faster than perSecond jobs per second. A time.Ticker delivers a value on
+// its channel at a fixed interval; waiting for a tick before each send
+// spaces the jobs out evenly. The output is closed once jobs is closed.
+//
+// perSecond must be greater than zero.
+func RateLimited(jobs <-chan int, perSecond int) <-chan int {
+	out := make(chan int)
+	ticker := time.NewTicker(time.Second / time.Duration(perSecond))
+
+	go func() {
+		defer close(out)
+		defer ticker.Stop() // Release the ticker's resources when done
+
+		for job := range jobs {
+			<-ticker.C // Wait for the next tick
+			out <- job
+		}
+	}()
+	return out
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRateLimited(t *testing.T) {
+	start := time.Now()
+
+	var got []int
+	for job := range RateLimited(sendAll(1, 2, 3, 4, 5), 5) {
+		got = append(got, job)
+	}
+	elapsed := time.Since(start)
+
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("jobs = %v; expected [1 2 3 4 5] in order", got)
+	}
+
+	// 5 jobs at 5 per second means one every 200ms, so about a second in
+	// total. Allow some slack, but it must clearly be throttled.
+	if elapsed < 800*time.Millisecond {
+		t.Errorf("5 jobs at 5/sec took %v; expected at least 800ms", elapsed)
+	}
+}
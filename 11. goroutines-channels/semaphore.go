@@ -0,0 +1,22 @@
+package main
+
+// Semaphore limits how many goroutines can do something at the same time.
+// It is a buffered channel used as a counter of free slots: Acquire puts a
+// value in (blocking once the buffer is full) and Release takes one out.
+type Semaphore chan struct{}
+
+// NewSemaphore creates a semaphore that lets at most n goroutines in at once
+func NewSemaphore(n int) Semaphore {
+	return make(Semaphore, n)
+}
+
+// Acquire takes a slot, waiting until one is free
+func (s Semaphore) Acquire() {
+	s <- struct{}{} // struct{} takes no memory - only the count matters
+}
+
+// Release gives a slot back. Every Acquire needs exactly one Release;
+// calling it with `defer` right after Acquire means it can't be forgotten.
+func (s Semaphore) Release() {
+	<-s
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSemaphoreLimitsConcurrency(t *testing.T) {
+	const limit = 3
+	const jobs = 20
+
+	sem := NewSemaphore(limit)
+	var running, maxRunning atomic.Int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem.Acquire()
+			defer sem.Release()
+
+			now := running.Add(1)
+			// Record the highest value seen; retry if another goroutine raced us
+			for {
+				highest := maxRunning.Load()
+				if now <= highest || maxRunning.CompareAndSwap(highest, now) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond) // Hold the slot so goroutines overlap
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	if got := maxRunning.Load(); got > limit {
+		t.Errorf("max concurrent goroutines = %d; expected at most %d", got, limit)
+	}
+	if got := maxRunning.Load(); got < 1 {
+		t.Errorf("max concurrent goroutines = %d; expected the jobs to run", got)
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// Machine-readable error codes. Clients should branch on these rather than
+// on Message, which is meant for people and may change wording.
+const (
+	CodeInvalidID        = "INVALID_ID"
+	CodeInvalidJSON      = "INVALID_JSON"
+	CodeInvalidBody      = "INVALID_BODY"
+	CodeInvalidQuery     = "INVALID_QUERY"
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeUserNotFound     = "USER_NOT_FOUND"
+	CodeUnauthorized     = "UNAUTHORIZED"
+	CodeRateLimited      = "RATE_LIMITED"
+	CodeInternal         = "INTERNAL_ERROR"
+)
+
+// ErrorResponse is the body of every 4xx and 5xx response
+type ErrorResponse struct {
+	XMLName xml.Name `json:"-" xml:"error"`
+	Code    string   `json:"code" xml:"code"`                                   // e.g. USER_NOT_FOUND
+	Message string   `json:"message" xml:"message"`                             // Human-readable summary
+	Details []string `json:"details,omitempty" xml:"details>detail,omitempty"` // e.g. one entry per invalid field
+}
+
+// sendError writes an ErrorResponse in the format the client asked for
+// (see sendResponse)
+func sendError(w http.ResponseWriter, r *http.Request, status int, code, msg string, details ...string) {
+	sendResponseBody(w, r, status, ErrorResponse{
+		Code:    code,
+		Message: msg,
+		Details: details,
+	})
+}
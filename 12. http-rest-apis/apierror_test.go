@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// decodeError reads an ErrorResponse out of a JSON response body
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) ErrorResponse {
+	t.Helper()
+	var resp ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("could not decode error response: %v", err)
+	}
+	return resp
+}
+
+func TestErrorCodes(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedCode string
+		status       int
+	}{
+		{"user not found", http.MethodGet, "/api/users/99", "", CodeUserNotFound, http.StatusNotFound},
+		{"delete missing user", http.MethodDelete, "/api/users/99", "", CodeUserNotFound, http.StatusNotFound},
+		{"invalid ID", http.MethodGet, "/api/users/abc", "", CodeInvalidID, http.StatusBadRequest},
+		{"invalid JSON", http.MethodPut, "/api/users/1", `{"name":`, CodeInvalidJSON, http.StatusBadRequest},
+		{"validation failed", http.MethodPost, "/api/users/create", `{"name":""}`, CodeValidationFailed, http.StatusUnprocessableEntity},
+		{"bad query", http.MethodGet, "/api/users?page=-1", "", CodeInvalidQuery, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTestUsers(t)
+			req := authorize(httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+			rec := httptest.NewRecorder()
+
+			newRouter().ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Errorf("status = %d; expected %d", rec.Code, tt.status)
+			}
+			if resp := decodeError(t, rec); resp.Code != tt.expectedCode {
+				t.Errorf("code = %q; expected %q", resp.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestUnauthorizedErrorCode(t *testing.T) {
+	req := httptest.NewRequest(http.MethodDelete, "/api/users/1", nil) // No token
+	rec := httptest.NewRecorder()
+
+	newRouter().ServeHTTP(rec, req)
+
+	if resp := decodeError(t, rec); resp.Code != CodeUnauthorized {
+		t.Errorf("code = %q; expected %q", resp.Code, CodeUnauthorized)
+	}
+}
+
+func TestSendErrorAsXML(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/xml")
+	rec := httptest.NewRecorder()
+
+	sendError(rec, req, http.StatusUnprocessableEntity, CodeValidationFailed, "Validation failed", "name: name is required")
+
+	var resp ErrorResponse
+	if err := xml.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("body is not valid XML: %v\n%s", err, rec.Body.String())
+	}
+	if resp.Code != CodeValidationFailed || len(resp.Details) != 1 {
+		t.Errorf("decoded error = %+v; expected VALIDATION_FAILED with one detail", resp)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestMain configures a bearer token for every test in the package, the way
+// main does from $API_TOKEN, then runs them
+func TestMain(m *testing.M) {
+	apiToken = "test-token"
+	os.Exit(m.Run())
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		header   string // Authorization header; empty means not sent
+		expected int
+	}{
+		{"valid token", http.MethodPost, "Bearer " + apiToken, http.StatusOK},
+		{"missing header", http.MethodPost, "", http.StatusUnauthorized},
+		{"wrong token", http.MethodDelete, "Bearer not-the-token", http.StatusUnauthorized},
+		{"wrong scheme", http.MethodPut, "Basic " + apiToken, http.StatusUnauthorized},
+		{"reads need no token", http.MethodGet, "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := authMiddleware(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, "/api/users/1", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			if rec.Code != tt.expected {
+				t.Errorf("status = %d; expected %d", rec.Code, tt.expected)
+			}
+			if called != (tt.expected == http.StatusOK) {
+				t.Errorf("next handler called = %v; expected %v", called, !called)
+			}
+			if tt.expected == http.StatusUnauthorized && !strings.Contains(rec.Header().Get("Content-Type"), "application/json") {
+				t.Errorf("Content-Type = %q; expected a JSON error", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestRouterRequiresTokenForCreate(t *testing.T) {
+	withTestUsers(t)
+	body := `{"name":"Jane Doe","email":"jane@example.com"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/users/create", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	newRouter().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d; expected %d", rec.Code, http.StatusUnauthorized)
+	}
+	if count := userCount(t); count != 2 {
+		t.Errorf("user count = %d; expected no user to be created", count)
+	}
+}
+
+func TestAuthMiddlewareFailsClosedWithoutToken(t *testing.T) {
+	original := apiToken
+	apiToken = ""
+	t.Cleanup(func() { apiToken = original })
+
+	handler := authMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("next handler called without a configured token")
+	})
+
+	// "Bearer " with an empty token must not match the empty configured token
+	for _, header := range []string{"Bearer ", "Bearer test-token"} {
+		req := httptest.NewRequest(http.MethodPost, "/api/users/create", nil)
+		req.Header.Set("Authorization", header)
+		rec := httptest.NewRecorder()
+
+		handler(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("%q: status = %d; expected %d", header, rec.Code, http.StatusUnauthorized)
+		}
+	}
+}
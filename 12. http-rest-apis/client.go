@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+)
+
+// apiBaseURL is where FetchUser looks users up (tests point it at a local server)
+var apiBaseURL = "https://jsonplaceholder.typicode.com"
+
+// retryBaseDelay is the wait before the second attempt; it doubles after
+// each further failure (tests shrink it so they run quickly)
+var retryBaseDelay = 200 * time.Millisecond
+
+// maxRetryDelay caps the backoff, however many attempts have failed
+var maxRetryDelay = 10 * time.Second
+
+// StatusError reports a response that wasn't 200 OK
+// Use errors.As to get at the StatusCode
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// NewAPIClient returns an HTTP client that gives up after timeout.
+// http.Get uses http.DefaultClient, which has no timeout at all: a server
+// that never answers would block the caller forever.
+func NewAPIClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout}
+}
+
+// FetchUser downloads user id from apiBaseURL and decodes the JSON body.
+// A non-200 response is returned as a *StatusError.
+func FetchUser(client *http.Client, id int) (*User, error) {
+	return FetchUserCtx(context.Background(), client, id)
+}
+
+// FetchUserCtx is FetchUser with a context: cancelling ctx, or reaching its
+// deadline, aborts the request even if the client's timeout is longer
+func FetchUserCtx(ctx context.Context, client *http.Client, id int) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/users/%d", apiBaseURL, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching user %d: %w", id, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching user %d: %w", id, err)
+	}
+	defer resp.Body.Close() // Always close the body, or the connection can't be reused
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching user %d: %w", id, &StatusError{StatusCode: resp.StatusCode})
+	}
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("decoding user %d: %w", id, err)
+	}
+	return &user, nil
+}
+
+// FetchUserWithRetry calls FetchUser up to maxAttempts times, retrying only
+// failures that might go away on their own: 5xx responses and network
+// errors. A 404 or a bad JSON body fails straight away, since asking again
+// would get the same answer.
+//
+// Between attempts it waits retryBaseDelay, then twice that, then four
+// times, and so on up to maxRetryDelay (exponential backoff), plus a random
+// extra of up to half the delay (jitter) so many clients don't all retry at
+// the same moment.
+func FetchUserWithRetry(client *http.Client, id int, maxAttempts int) (*User, error) {
+	return FetchUserWithRetryCtx(context.Background(), client, id, maxAttempts)
+}
+
+// FetchUserWithRetryCtx is FetchUserWithRetry that also stays within ctx.
+// Each request is made with ctx, and before each backoff it checks ctx's
+// deadline: if the wait would end at or after the deadline, the next
+// attempt could never finish, so it stops straight away with the last
+// error instead of sleeping. Cancelling ctx during a backoff cuts the wait
+// short and returns ctx.Err().
+func FetchUserWithRetryCtx(ctx context.Context, client *http.Client, id int, maxAttempts int) (*User, error) {
+	maxAttempts = max(maxAttempts, 1) // Always try at least once
+
+	var err error
+	attempts := 0
+	for attempts < maxAttempts {
+		attempts++
+		var user *User
+		user, err = FetchUserCtx(ctx, client, id)
+		if err == nil {
+			return user, nil
+		}
+		if !isTransient(err) || attempts == maxAttempts || ctx.Err() != nil {
+			break
+		}
+
+		delay := withJitter(retryDelay(attempts))
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			break // Waiting would use up the time left for the next attempt
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("giving up after %d attempt(s): %w", attempts, ctx.Err())
+		}
+	}
+	return nil, fmt.Errorf("giving up after %d attempt(s): %w", attempts, err)
+}
+
+// retryDelay returns the backoff to wait after the given failed attempt:
+// retryBaseDelay doubled once per earlier failure, capped at maxRetryDelay.
+// Doubling in a loop (rather than retryBaseDelay << (attempt-1)) means a
+// large attempt number can't overflow into a negative duration.
+func retryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt && delay > 0 && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// withJitter adds a random extra of up to half of delay
+func withJitter(delay time.Duration) time.Duration {
+	return delay + time.Duration(rand.Int64N(int64(delay)/2+1))
+}
+
+// isTransient reports whether err is worth retrying
+func isTransient(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+	var netErr net.Error // Timeouts, refused connections, DNS failures...
+	return errors.As(err, &netErr)
+}
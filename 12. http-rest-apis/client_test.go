@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// withAPIServer starts a test server running handler and points
+// FetchUser at it for the rest of the test
+func withAPIServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := apiBaseURL
+	apiBaseURL = server.URL
+	t.Cleanup(func() { apiBaseURL = original })
+}
+
+func TestFetchUser(t *testing.T) {
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/7" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":7,"name":"Jane Doe","email":"jane@example.com"}`))
+	})
+
+	user, err := FetchUser(NewAPIClient(time.Second), 7)
+	if err != nil {
+		t.Fatalf("FetchUser returned error: %v", err)
+	}
+	if user.ID != 7 || user.Name != "Jane Doe" || user.Email != "jane@example.com" {
+		t.Errorf("FetchUser = %+v; expected Jane Doe with ID 7", user)
+	}
+}
+
+func TestFetchUserNon200(t *testing.T) {
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+
+	_, err := FetchUser(NewAPIClient(time.Second), 1)
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("error = %v; expected a *StatusError", err)
+	}
+	if statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d; expected %d", statusErr.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestFetchUserInvalidJSON(t *testing.T) {
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":`))
+	})
+
+	if _, err := FetchUser(NewAPIClient(time.Second), 1); err == nil {
+		t.Error("expected an error for a truncated JSON body")
+	}
+}
+
+func TestFetchUserTimeout(t *testing.T) {
+	release := make(chan struct{})
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release // Hang until the test is over
+	})
+	t.Cleanup(func() { close(release) }) // Runs before server.Close, so it doesn't wait on us
+
+	start := time.Now()
+	_, err := FetchUser(NewAPIClient(50*time.Millisecond), 1)
+
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("error = %v; expected a timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("FetchUser took %v; expected it to give up after about 50ms", elapsed)
+	}
+}
+
+// withFastRetries shrinks the backoff delay for one test
+func withFastRetries(t *testing.T) {
+	t.Helper()
+	original := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = original })
+}
+
+func TestFetchUserWithRetryEventuallySucceeds(t *testing.T) {
+	withFastRetries(t)
+	var attempts atomic.Int32
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) <= 2 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":1,"name":"Jane Doe","email":"jane@example.com"}`))
+	})
+
+	user, err := FetchUserWithRetry(NewAPIClient(time.Second), 1, 5)
+	if err != nil {
+		t.Fatalf("FetchUserWithRetry returned error: %v", err)
+	}
+	if user.Name != "Jane Doe" {
+		t.Errorf("Name = %q; expected %q", user.Name, "Jane Doe")
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d; expected 3 (two failures, then success)", got)
+	}
+}
+
+func TestFetchUserWithRetryGivesUp(t *testing.T) {
+	withFastRetries(t)
+	var attempts atomic.Int32
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		http.Error(w, "broken", http.StatusInternalServerError)
+	})
+
+	_, err := FetchUserWithRetry(NewAPIClient(time.Second), 1, 3)
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("error = %v; expected the last 500 StatusError", err)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d; expected 3", got)
+	}
+}
+
+func TestFetchUserWithRetrySkipsPermanentErrors(t *testing.T) {
+	withFastRetries(t)
+	var attempts atomic.Int32
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		http.NotFound(w, r)
+	})
+
+	_, err := FetchUserWithRetry(NewAPIClient(time.Second), 1, 5)
+	if err == nil {
+		t.Fatal("expected an error for a 404")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d; expected a 404 not to be retried", got)
+	}
+	if !strings.Contains(err.Error(), "after 1 attempt(s)") {
+		t.Errorf("error = %q; expected it to report 1 attempt, not maxAttempts", err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{1, 200 * time.Millisecond},
+		{2, 400 * time.Millisecond},
+		{3, 800 * time.Millisecond},
+		{7, 10 * time.Second}, // 12.8s, capped
+		{100, 10 * time.Second},
+		{math.MaxInt, 10 * time.Second}, // A plain shift would overflow here
+	}
+
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempt); got != tt.expected {
+			t.Errorf("retryDelay(%d) = %v; expected %v", tt.attempt, got, tt.expected)
+		}
+		if got := withJitter(retryDelay(tt.attempt)); got < tt.expected || got > tt.expected*3/2 {
+			t.Errorf("withJitter(%v) = %v; expected between 1x and 1.5x", tt.expected, got)
+		}
+	}
+}
+
+func TestFetchUserWithRetryCtxStopsBeforeDeadline(t *testing.T) {
+	// A server that keeps failing: without the deadline check, 20 attempts
+	// with 50ms, 100ms, 200ms... backoffs would take far longer than 300ms
+	original := retryBaseDelay
+	retryBaseDelay = 50 * time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = original })
+
+	var attempts atomic.Int32
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		http.Error(w, "try again", http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	_, err := FetchUserWithRetryCtx(ctx, NewAPIClient(time.Second), 1, 20)
+
+	if time.Now().After(deadline) {
+		t.Errorf("returned %v after the deadline; expected it to give up before", time.Since(deadline))
+	}
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("error = %v; expected the last 503 StatusError", err)
+	}
+	if got := attempts.Load(); got < 2 || got >= 20 {
+		t.Errorf("attempts = %d; expected a few retries, then an early stop", got)
+	}
+}
+
+func TestFetchUserWithRetryCtxSucceedsWithinDeadline(t *testing.T) {
+	withFastRetries(t)
+	var attempts atomic.Int32
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) <= 2 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":1,"name":"Jane Doe","email":"jane@example.com"}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	user, err := FetchUserWithRetryCtx(ctx, NewAPIClient(time.Second), 1, 5)
+	if err != nil || user.Name != "Jane Doe" {
+		t.Errorf("FetchUserWithRetryCtx = %v, %v; expected Jane Doe", user, err)
+	}
+}
+
+func TestFetchUserWithRetryCtxHangingServer(t *testing.T) {
+	release := make(chan struct{})
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	t.Cleanup(func() { close(release) })
+
+	// The client would wait 10s, but the context ends the request sooner
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := FetchUserWithRetryCtx(ctx, NewAPIClient(10*time.Second), 1, 5)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v; expected context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("took %v; expected it to stop at the 50ms deadline", elapsed)
+	}
+}
+
+func TestFetchUserWithRetryCtxCancelDuringBackoff(t *testing.T) {
+	// A long backoff with no deadline: only cancelling can end the wait
+	original := retryBaseDelay
+	retryBaseDelay = 5 * time.Second
+	t.Cleanup(func() { retryBaseDelay = original })
+
+	withAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "try again", http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := FetchUserWithRetryCtx(ctx, NewAPIClient(time.Second), 1, 3)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v; expected context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("took %v; expected cancel to cut the 5s backoff short", elapsed)
+	}
+}
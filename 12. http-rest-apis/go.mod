@@ -0,0 +1,3 @@
+module http-rest-apis
+
+go 1.23.0
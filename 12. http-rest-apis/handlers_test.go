@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withStore gives one test its own in-memory store holding seed and puts
+// the original back afterwards, so handler tests don't affect each other
+func withStore(t *testing.T, seed ...User) {
+	t.Helper()
+	original := store
+	store = NewMemoryStore(seed...)
+	t.Cleanup(func() { store = original })
+}
+
+// withTestUsers sets up a store with two known users (IDs 1 and 2)
+func withTestUsers(t *testing.T) {
+	t.Helper()
+	withStore(t,
+		User{ID: 1, Name: "Alice Johnson", Email: "alice@example.com", CreatedAt: time.Now()},
+		User{ID: 2, Name: "Bob Smith", Email: "bob@example.com", CreatedAt: time.Now()},
+	)
+}
+
+// storedUser fetches a user straight from the store
+func storedUser(t *testing.T, id int) User {
+	t.Helper()
+	u, err := store.Get(id)
+	if err != nil {
+		t.Fatalf("store.Get(%d): %v", id, err)
+	}
+	return u
+}
+
+// userCount returns how many users the store holds
+func userCount(t *testing.T) int {
+	t.Helper()
+	list, err := store.List()
+	if err != nil {
+		t.Fatalf("store.List: %v", err)
+	}
+	return len(list)
+}
+
+// authorize adds the bearer token that mutating routes require
+func authorize(req *http.Request) *http.Request {
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	return req
+}
+
+// decodeUser reads the User out of a Response body
+func decodeUser(t *testing.T, rec *httptest.ResponseRecorder) User {
+	t.Helper()
+	var resp struct {
+		Success bool `json:"success"`
+		Data    User `json:"data"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	return resp.Data
+}
+
+func TestUpdateUserHandlerReplaces(t *testing.T) {
+	withTestUsers(t)
+
+	body := `{"name":"Alice Cooper","email":"cooper@example.com"}`
+	req := authorize(httptest.NewRequest(http.MethodPut, "/api/users/1", strings.NewReader(body)))
+	rec := httptest.NewRecorder()
+
+	newRouter().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d; expected %d", rec.Code, http.StatusOK)
+	}
+	got := decodeUser(t, rec)
+	if got.ID != 1 || got.Name != "Alice Cooper" || got.Email != "cooper@example.com" {
+		t.Errorf("response user = %+v; expected ID 1 with the new name and email", got)
+	}
+	if stored := storedUser(t, 1); stored.Name != "Alice Cooper" || stored.Email != "cooper@example.com" {
+		t.Errorf("stored user = %+v; expected it to be updated", stored)
+	}
+}
+
+func TestUpdateUserHandlerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		expected int
+	}{
+		{"unknown user", "/api/users/99", `{"name":"X","email":"x@example.com"}`, http.StatusNotFound},
+		{"invalid JSON", "/api/users/1", `{"name":`, http.StatusBadRequest},
+		{"missing field", "/api/users/1", `{"name":"Only Name"}`, http.StatusUnprocessableEntity},
+		{"invalid ID", "/api/users/abc", `{"name":"X","email":"x@example.com"}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTestUsers(t)
+			req := authorize(httptest.NewRequest(http.MethodPut, tt.path, strings.NewReader(tt.body)))
+			rec := httptest.NewRecorder()
+
+			newRouter().ServeHTTP(rec, req)
+
+			if rec.Code != tt.expected {
+				t.Errorf("status = %d; expected %d", rec.Code, tt.expected)
+			}
+			if stored := storedUser(t, 1); stored.Name != "Alice Johnson" {
+				t.Errorf("stored user changed to %+v on a failed update", stored)
+			}
+		})
+	}
+}
+
+func TestPatchUserHandlerPartialUpdate(t *testing.T) {
+	withTestUsers(t)
+
+	req := authorize(httptest.NewRequest(http.MethodPatch, "/api/users/2", strings.NewReader(`{"email":"robert@example.com"}`)))
+	rec := httptest.NewRecorder()
+
+	newRouter().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d; expected %d", rec.Code, http.StatusOK)
+	}
+	got := decodeUser(t, rec)
+	if got.Name != "Bob Smith" {
+		t.Errorf("Name = %q; expected it to stay %q", got.Name, "Bob Smith")
+	}
+	if got.Email != "robert@example.com" {
+		t.Errorf("Email = %q; expected %q", got.Email, "robert@example.com")
+	}
+	if stored := storedUser(t, 2); stored.Email != "robert@example.com" {
+		t.Errorf("stored Email = %q; expected it to be updated", stored.Email)
+	}
+}
+
+func TestPatchUserHandlerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		expected int
+	}{
+		{"unknown user", "/api/users/99", `{"name":"X"}`, http.StatusNotFound},
+		{"invalid JSON", "/api/users/2", `not json`, http.StatusBadRequest},
+		{"empty name", "/api/users/2", `{"name":""}`, http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTestUsers(t)
+			req := authorize(httptest.NewRequest(http.MethodPatch, tt.path, strings.NewReader(tt.body)))
+			rec := httptest.NewRecorder()
+
+			newRouter().ServeHTTP(rec, req)
+
+			if rec.Code != tt.expected {
+				t.Errorf("status = %d; expected %d", rec.Code, tt.expected)
+			}
+			if stored := storedUser(t, 2); stored.Name != "Bob Smith" {
+				t.Errorf("stored user changed to %+v on a failed patch", stored)
+			}
+		})
+	}
+}
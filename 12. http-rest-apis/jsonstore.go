@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// jsonStoreFile is what JSONStore writes to disk. NextID is saved too, so
+// a deleted user's ID is never handed out again after a restart.
+type jsonStoreFile struct {
+	NextID int    `json:"next_id"`
+	Users  []User `json:"users"`
+}
+
+// JSONStore is a UserStore that keeps users in a JSON file, so they
+// survive a restart. Reads are served from memory; every change rewrites
+// the whole file.
+type JSONStore struct {
+	mu   sync.Mutex // Serialises changes so file writes happen in order
+	path string
+	mem  *MemoryStore
+}
+
+// OpenJSONStore loads users from path. A missing file is not an error:
+// the store starts empty and the file is created on the first change.
+func OpenJSONStore(path string) (*JSONStore, error) {
+	s := &JSONStore{path: path, mem: NewMemoryStore()}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading user store: %w", err)
+	}
+
+	var file jsonStoreFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	s.mem = NewMemoryStore(file.Users...)
+	s.mem.nextID = max(s.mem.nextID, file.NextID)
+	return s, nil
+}
+
+func (s *JSONStore) List() ([]User, error) { return s.mem.List() }
+
+func (s *JSONStore) Get(id int) (User, error) { return s.mem.Get(id) }
+
+func (s *JSONStore) Create(u User) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.snapshot()
+	created, err := s.mem.Create(u)
+	if err != nil {
+		return User{}, err
+	}
+	if err := s.saveOrRollback(before); err != nil {
+		return User{}, err
+	}
+	return created, nil
+}
+
+func (s *JSONStore) Update(u User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.snapshot()
+	if err := s.mem.Update(u); err != nil {
+		return err
+	}
+	return s.saveOrRollback(before)
+}
+
+func (s *JSONStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.snapshot()
+	if err := s.mem.Delete(id); err != nil {
+		return err
+	}
+	return s.saveOrRollback(before)
+}
+
+// snapshot copies the current state, so a failed save can be undone
+func (s *JSONStore) snapshot() jsonStoreFile {
+	s.mem.mu.RLock()
+	defer s.mem.mu.RUnlock()
+
+	return jsonStoreFile{NextID: s.mem.nextID, Users: slices.Clone(s.mem.users)}
+}
+
+// saveOrRollback writes the current state to disk. If that fails, memory
+// is reset to before, so it never shows a change the file doesn't have.
+// The caller must hold s.mu
+func (s *JSONStore) saveOrRollback(before jsonStoreFile) error {
+	err := writeFileAtomic(s.path, s.snapshot())
+	if err != nil {
+		s.mem.mu.Lock()
+		s.mem.users, s.mem.nextID = before.Users, before.NextID
+		s.mem.mu.Unlock()
+		return fmt.Errorf("saving user store: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes v as JSON to a temporary file in the same
+// directory and then renames it over path. A rename within one directory
+// is atomic, so a crash mid-write leaves the old file intact instead of a
+// half-written one.
+func writeFileAtomic(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // Cleans up on failure; a no-op after the rename
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil { // Make sure the bytes reach the disk
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
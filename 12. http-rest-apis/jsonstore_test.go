@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJSONStorePersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.json")
+
+	s, err := OpenJSONStore(path)
+	if err != nil {
+		t.Fatalf("OpenJSONStore: %v", err)
+	}
+	jane, err := s.Create(User{Name: "Jane Doe", Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := s.Create(User{Name: "John Roe", Email: "john@example.com"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// Simulate a restart: a fresh store reading the same file
+	reopened, err := OpenJSONStore(path)
+	if err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+	got, err := reopened.Get(jane.ID)
+	if err != nil {
+		t.Fatalf("Get(%d) after reopen: %v", jane.ID, err)
+	}
+	if got.Name != "Jane Doe" || got.Email != "jane@example.com" || !got.CreatedAt.Equal(jane.CreatedAt) {
+		t.Errorf("user after reopen = %+v; expected %+v", got, jane)
+	}
+}
+
+func TestJSONStoreUpdateAndDeletePersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.json")
+	s, _ := OpenJSONStore(path)
+	first, _ := s.Create(User{Name: "First", Email: "first@example.com"})
+	second, _ := s.Create(User{Name: "Second", Email: "second@example.com"})
+
+	first.Name = "Renamed"
+	if err := s.Update(first); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if err := s.Delete(second.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	reopened, err := OpenJSONStore(path)
+	if err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+	if got, _ := reopened.Get(first.ID); got.Name != "Renamed" {
+		t.Errorf("Name after reopen = %q; expected %q", got.Name, "Renamed")
+	}
+	if _, err := reopened.Get(second.ID); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Get(deleted) error = %v; expected ErrUserNotFound", err)
+	}
+
+	// The deleted ID must not be reused after a restart
+	third, _ := reopened.Create(User{Name: "Third", Email: "third@example.com"})
+	if third.ID <= second.ID {
+		t.Errorf("new ID = %d; expected more than the deleted ID %d", third.ID, second.ID)
+	}
+}
+
+func TestJSONStoreLeavesNoTempFiles(t *testing.T) {
+	dir := t.TempDir()
+	s, _ := OpenJSONStore(filepath.Join(dir, "users.json"))
+	s.Create(User{Name: "Jane", Email: "jane@example.com"})
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "users.json" {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("directory contains %v; expected only users.json", names)
+	}
+}
+
+func TestJSONStoreRollsBackWhenSaveFails(t *testing.T) {
+	// The directory doesn't exist, so writing the file fails
+	s, err := OpenJSONStore(filepath.Join(t.TempDir(), "missing", "users.json"))
+	if err != nil {
+		t.Fatalf("OpenJSONStore: %v", err)
+	}
+
+	if _, err := s.Create(User{Name: "Jane", Email: "jane@example.com"}); err == nil {
+		t.Fatal("Create succeeded; expected the save to fail")
+	}
+	if list, _ := s.List(); len(list) != 0 {
+		t.Errorf("store holds %v after a failed save; expected no users", list)
+	}
+}
+
+func TestOpenJSONStoreCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.json")
+	os.WriteFile(path, []byte("{not json"), 0o644)
+
+	if _, err := OpenJSONStore(path); err == nil {
+		t.Error("expected an error for a corrupt file")
+	}
+}
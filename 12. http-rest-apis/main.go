@@ -1,37 +1,48 @@
 package main
 
 import (
+	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // User struct for JSON examples
 type User struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        int       `json:"id" xml:"id"`
+	Name      string    `json:"name" xml:"name"`
+	Email     string    `json:"email" xml:"email"`
+	CreatedAt time.Time `json:"created_at" xml:"created_at"`
 }
 
 // Response struct for consistent API responses
+// XMLName sets the root element when the client asks for XML
 type Response struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message,omitempty"`
-	Data    interface{} `json:"data,omitempty"`
+	XMLName xml.Name    `json:"-" xml:"response"`
+	Success bool        `json:"success" xml:"success"`
+	Message string      `json:"message,omitempty" xml:"message,omitempty"`
+	Data    interface{} `json:"data,omitempty" xml:"data,omitempty"`
 }
 
-// In-memory user storage (simulating a database)
-var users = []User{
+// Sample users added when the store starts out empty
+var sampleUsers = []User{
 	{ID: 1, Name: "Alice Johnson", Email: "alice@example.com", CreatedAt: time.Now()},
 	{ID: 2, Name: "Bob Smith", Email: "bob@example.com", CreatedAt: time.Now()},
 	{ID: 3, Name: "Charlie Brown", Email: "charlie@example.com", CreatedAt: time.Now()},
 }
-var nextID = 4
+
+// User storage used by the handlers (see store.go)
+// main swaps in a JSONStore so users survive a restart
+var store UserStore = NewMemoryStore(sampleUsers...)
 
 // --- Handlers ---
 
@@ -40,84 +51,71 @@ func homeHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/html")
 	fmt.Fprintf(w, "<h1>Welcome to Go REST API</h1><p>Try the following endpoints:</p>")
 	fmt.Fprintf(w, "<ul>")
-	fmt.Fprintf(w, "<li>GET /api/users - Get all users</li>")
+	fmt.Fprintf(w, "<li>GET /api/users?page=1&limit=20&sort=name&order=desc - Get a page of users</li>")
 	fmt.Fprintf(w, "<li>GET /api/users/{id} - Get user by ID</li>")
 	fmt.Fprintf(w, "<li>POST /api/users/create - Create new user</li>")
+	fmt.Fprintf(w, "<li>PUT /api/users/{id} - Replace user's name and email</li>")
+	fmt.Fprintf(w, "<li>PATCH /api/users/{id} - Update only the fields sent</li>")
 	fmt.Fprintf(w, "<li>DELETE /api/users/{id} - Delete user</li>")
 	fmt.Fprintf(w, "</ul>")
 }
 
 // Get all users
 func getUsersHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		sendJSONResponse(w, http.StatusMethodNotAllowed, Response{
-			Success: false,
-			Message: "Method not allowed",
-		})
+	// Pagination: ?page=2&limit=10
+	page, limit, err := parsePageParams(r)
+	if err != nil {
+		sendError(w, r, http.StatusBadRequest, CodeInvalidQuery, err.Error())
+		return
+	}
+
+	users, err := store.List()
+	if err != nil {
+		sendError(w, r, http.StatusInternalServerError, CodeInternal, "Could not load users")
+		return
+	}
+
+	// Sorting: ?sort=name&order=desc
+	sorted, err := sortedUsers(r, users)
+	if err != nil {
+		sendError(w, r, http.StatusBadRequest, CodeInvalidQuery, err.Error())
 		return
 	}
 
-	sendJSONResponse(w, http.StatusOK, Response{
+	sendResponse(w, r, http.StatusOK, Response{
 		Success: true,
-		Data:    users,
+		Data:    paginate(sorted, page, limit),
 	})
 }
 
 // Get user by ID
 func getUserByIDHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		sendJSONResponse(w, http.StatusMethodNotAllowed, Response{
-			Success: false,
-			Message: "Method not allowed",
-		})
-		return
-	}
-
-	// Extract ID from URL path (simple parsing)
-	idStr := r.URL.Path[len("/api/users/"):]
-	id, err := strconv.Atoi(idStr)
+	// {id} from the route pattern
+	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
-		sendJSONResponse(w, http.StatusBadRequest, Response{
-			Success: false,
-			Message: "Invalid user ID",
-		})
+		sendError(w, r, http.StatusBadRequest, CodeInvalidID, "Invalid user ID")
 		return
 	}
 
 	// Find user
-	for _, user := range users {
-		if user.ID == id {
-			sendJSONResponse(w, http.StatusOK, Response{
-				Success: true,
-				Data:    user,
-			})
-			return
-		}
+	user, err := store.Get(id)
+	if err != nil {
+		sendStoreError(w, r, err)
+		return
 	}
 
-	sendJSONResponse(w, http.StatusNotFound, Response{
-		Success: false,
-		Message: "User not found",
+	sendResponse(w, r, http.StatusOK, Response{
+		Success: true,
+		Data:    user,
 	})
 }
 
 // Create new user
 func createUserHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		sendJSONResponse(w, http.StatusMethodNotAllowed, Response{
-			Success: false,
-			Message: "Method not allowed",
-		})
-		return
-	}
-
 	// Read request body
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		sendJSONResponse(w, http.StatusBadRequest, Response{
-			Success: false,
-			Message: "Error reading request body",
-		})
+		sendError(w, r, http.StatusBadRequest, CodeInvalidBody, "Error reading request body")
 		return
 	}
 	defer r.Body.Close()
@@ -126,79 +124,171 @@ func createUserHandler(w http.ResponseWriter, r *http.Request) {
 	var newUser User
 	err = json.Unmarshal(body, &newUser)
 	if err != nil {
-		sendJSONResponse(w, http.StatusBadRequest, Response{
-			Success: false,
-			Message: "Invalid JSON format",
-		})
+		sendError(w, r, http.StatusBadRequest, CodeInvalidJSON, "Invalid JSON format")
 		return
 	}
 
-	// Validate
-	if newUser.Name == "" || newUser.Email == "" {
-		sendJSONResponse(w, http.StatusBadRequest, Response{
-			Success: false,
-			Message: "Name and email are required",
-		})
+	// Validate (reports every problem, not just the first)
+	if problems := validateUser(newUser); problems != nil {
+		sendError(w, r, http.StatusUnprocessableEntity, CodeValidationFailed, "Validation failed", problems...)
 		return
 	}
 
-	// Create user
-	newUser.ID = nextID
-	nextID++
-	newUser.CreatedAt = time.Now()
-	users = append(users, newUser)
+	// Create user (the store assigns ID and CreatedAt)
+	newUser, err = store.Create(newUser)
+	if err != nil {
+		sendStoreError(w, r, err)
+		return
+	}
 
-	sendJSONResponse(w, http.StatusCreated, Response{
+	// Log the new user without writing their email address to the logs
+	if masked, err := MaskFields(newUser, "email"); err == nil {
+		log.Printf("Created user: %s", masked)
+	}
+
+	sendResponse(w, r, http.StatusCreated, Response{
 		Success: true,
 		Message: "User created successfully",
 		Data:    newUser,
 	})
 }
 
-// Delete user
-func deleteUserHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		sendJSONResponse(w, http.StatusMethodNotAllowed, Response{
-			Success: false,
-			Message: "Method not allowed",
-		})
+// Update user (PUT replaces name and email)
+func updateUserHandler(w http.ResponseWriter, r *http.Request) {
+	// {id} from the route pattern
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		sendError(w, r, http.StatusBadRequest, CodeInvalidID, "Invalid user ID")
+		return
+	}
+
+	// Parse JSON
+	var input User
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendError(w, r, http.StatusBadRequest, CodeInvalidJSON, "Invalid JSON format")
+		return
+	}
+
+	// PUT replaces the whole resource, so every field must be valid
+	if problems := validateUser(input); problems != nil {
+		sendError(w, r, http.StatusUnprocessableEntity, CodeValidationFailed, "Validation failed", problems...)
+		return
+	}
+
+	// Find and update user (ID and CreatedAt stay the same)
+	user, err := store.Get(id)
+	if err != nil {
+		sendStoreError(w, r, err)
+		return
+	}
+	user.Name = input.Name
+	user.Email = input.Email
+
+	if err := store.Update(user); err != nil {
+		sendStoreError(w, r, err)
+		return
+	}
+
+	sendResponse(w, r, http.StatusOK, Response{
+		Success: true,
+		Message: "User updated successfully",
+		Data:    user,
+	})
+}
+
+// userPatch holds the fields a PATCH request may change. Pointers tell us
+// whether a field was sent at all: nil means "leave it alone", while a
+// pointer to "" means the client really sent an empty string.
+type userPatch struct {
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+}
+
+// Partially update user (PATCH changes only the fields that were sent)
+func patchUserHandler(w http.ResponseWriter, r *http.Request) {
+	// {id} from the route pattern
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		sendError(w, r, http.StatusBadRequest, CodeInvalidID, "Invalid user ID")
+		return
+	}
+
+	// Parse JSON
+	var patch userPatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		sendError(w, r, http.StatusBadRequest, CodeInvalidJSON, "Invalid JSON format")
+		return
+	}
+
+	updated, err := store.Get(id)
+	if err != nil {
+		sendStoreError(w, r, err)
+		return
+	}
+
+	// Apply the patch to our copy and validate it before saving
+	if patch.Name != nil {
+		updated.Name = *patch.Name
+	}
+	if patch.Email != nil {
+		updated.Email = *patch.Email
+	}
+
+	if problems := validateUser(updated); problems != nil {
+		sendError(w, r, http.StatusUnprocessableEntity, CodeValidationFailed, "Validation failed", problems...)
+		return
+	}
+
+	if err := store.Update(updated); err != nil {
+		sendStoreError(w, r, err)
 		return
 	}
 
-	// Extract ID from URL path
-	idStr := r.URL.Path[len("/api/users/"):]
-	id, err := strconv.Atoi(idStr)
+	sendResponse(w, r, http.StatusOK, Response{
+		Success: true,
+		Message: "User updated successfully",
+		Data:    updated,
+	})
+}
+
+// Delete user
+func deleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	// {id} from the route pattern
+	id, err := strconv.Atoi(r.PathValue("id"))
 	if err != nil {
-		sendJSONResponse(w, http.StatusBadRequest, Response{
-			Success: false,
-			Message: "Invalid user ID",
-		})
+		sendError(w, r, http.StatusBadRequest, CodeInvalidID, "Invalid user ID")
 		return
 	}
 
 	// Find and delete user
-	for i, user := range users {
-		if user.ID == id {
-			users = append(users[:i], users[i+1:]...)
-			sendJSONResponse(w, http.StatusOK, Response{
-				Success: true,
-				Message: "User deleted successfully",
-			})
-			return
-		}
+	if err := store.Delete(id); err != nil {
+		sendStoreError(w, r, err)
+		return
 	}
 
-	sendJSONResponse(w, http.StatusNotFound, Response{
-		Success: false,
-		Message: "User not found",
+	sendResponse(w, r, http.StatusOK, Response{
+		Success: true,
+		Message: "User deleted successfully",
 	})
 }
 
 // Helper function to send JSON responses
-func sendJSONResponse(w http.ResponseWriter, statusCode int, response Response) {
+func sendJSONResponse(w http.ResponseWriter, statusCode int, body any) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(body)
+}
+
+// sendStoreError answers 404 for a missing user and 500 for anything else
+// (such as a failed write to disk), without showing internal details
+func sendStoreError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrUserNotFound) {
+		sendError(w, r, http.StatusNotFound, CodeUserNotFound, "User not found")
+		return
+	}
+
+	log.Printf("Store error: %v", err)
+	sendError(w, r, http.StatusInternalServerError, CodeInternal, "Internal server error")
 }
 
 // --- Middleware ---
@@ -207,9 +297,38 @@ func sendJSONResponse(w http.ResponseWriter, statusCode int, response Response)
 func loggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
-		log.Printf("Started %s %s", r.Method, r.URL.Path)
+		id := RequestIDFromContext(r.Context())
+		log.Printf("[%s] Started %s %s", id, r.Method, r.URL.Path)
+		next(w, r)
+		log.Printf("[%s] Completed in %v", id, time.Since(start))
+	}
+}
+
+// apiToken is the bearer token clients must send to change data.
+// main sets it from $API_TOKEN and refuses to start without one. While it is
+// empty every mutating request is rejected, so a missing token fails closed.
+var apiToken string
+
+// Auth middleware: requests that change data (POST, PUT, PATCH, DELETE)
+// must send "Authorization: Bearer <apiToken>". Reads are left public.
+func authMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next(w, r)
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		// ConstantTimeCompare takes the same time however many bytes match,
+		// so the response time doesn't leak how close a guess was
+		if !ok || apiToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(apiToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			sendError(w, r, http.StatusUnauthorized, CodeUnauthorized, "Missing or invalid bearer token")
+			return
+		}
+
 		next(w, r)
-		log.Printf("Completed in %v", time.Since(start))
 	}
 }
 
@@ -217,8 +336,9 @@ func loggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
 func corsMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 		// Handle preflight requests
 		if r.Method == http.MethodOptions {
@@ -231,54 +351,73 @@ func corsMiddleware(next http.HandlerFunc) http.HandlerFunc {
 }
 
 // Chain middleware
-func withMiddleware(handler http.HandlerFunc) http.HandlerFunc {
-	return corsMiddleware(loggingMiddleware(handler))
+// The request ID comes first so every later log line can include it.
+// Rate limiting runs before auth, so guessing tokens is throttled too.
+func withMiddleware(handler http.HandlerFunc, limiter *IPRateLimiter) http.HandlerFunc {
+	return requestIDMiddleware(corsMiddleware(loggingMiddleware(rateLimitMiddleware(limiter, authMiddleware(handler)))))
 }
 
 // --- HTTP Client Example ---
 
-// Example function to make HTTP GET request
+// Example of calling another API with a client that has a timeout
 func fetchUserExample() {
-	resp, err := http.Get("https://jsonplaceholder.typicode.com/users/1")
-	if err != nil {
-		log.Printf("Error making request: %v", err)
-		return
-	}
-	defer resp.Body.Close()
+	client := NewAPIClient(5 * time.Second)
 
-	body, err := io.ReadAll(resp.Body)
+	// Up to 3 tries if the server is having trouble, but 10s in total at most
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	user, err := FetchUserWithRetryCtx(ctx, client, 1, 3)
 	if err != nil {
-		log.Printf("Error reading response: %v", err)
+		log.Printf("Error fetching user: %v", err)
 		return
 	}
 
 	fmt.Println("\n--- HTTP Client Example ---")
-	fmt.Printf("Status Code: %d\n", resp.StatusCode)
-	fmt.Printf("Response: %s\n", string(body))
+	fmt.Printf("Fetched user %d: %s <%s>\n", user.ID, user.Name, user.Email)
+}
+
+// newRouter registers every route on its own ServeMux. Since Go 1.22 a
+// pattern can include the method and {wildcards}: the mux answers 405 for
+// a wrong method and 404 for paths that don't match, and the handler reads
+// the wildcard with r.PathValue("id").
+func newRouter() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", homeHandler) // {$} matches "/" only, not every path
+	mux.HandleFunc("GET /api/users", getUsersHandler)
+	mux.HandleFunc("POST /api/users/create", createUserHandler)
+	mux.HandleFunc("GET /api/users/{id}", getUserByIDHandler)
+	mux.HandleFunc("PUT /api/users/{id}", updateUserHandler)
+	mux.HandleFunc("PATCH /api/users/{id}", patchUserHandler)
+	mux.HandleFunc("DELETE /api/users/{id}", deleteUserHandler)
+
+	// 10 requests per second per client IP, with bursts of up to 20
+	limiter := NewIPRateLimiter(10, 20)
+
+	// Wrap the whole mux so CORS preflight (OPTIONS) requests are answered
+	// before routing, for every path
+	return withMiddleware(mux.ServeHTTP, limiter)
 }
 
 func main() {
-	// Register routes
-	http.HandleFunc("/", withMiddleware(homeHandler))
-	http.HandleFunc("/api/users", withMiddleware(getUsersHandler))
-	http.HandleFunc("/api/users/", func(w http.ResponseWriter, r *http.Request) {
-		// Route to appropriate handler based on method and path
-		if r.URL.Path == "/api/users/" || r.URL.Path == "/api/users" {
-			withMiddleware(getUsersHandler)(w, r)
-		} else if r.Method == http.MethodGet {
-			withMiddleware(getUserByIDHandler)(w, r)
-		} else if r.Method == http.MethodDelete {
-			withMiddleware(deleteUserHandler)(w, r)
-		} else {
-			sendJSONResponse(w, http.StatusMethodNotAllowed, Response{
-				Success: false,
-				Message: "Method not allowed",
-			})
-		}
-	})
+	// No default token: one checked into the repo would let anyone change data
+	apiToken = os.Getenv("API_TOKEN")
+	if apiToken == "" {
+		log.Fatal("API_TOKEN is not set; start the server with e.g. API_TOKEN=$(openssl rand -hex 16) go run .")
+	}
 
-	// Create endpoint for POST requests
-	http.HandleFunc("/api/users/create", withMiddleware(createUserHandler))
+	// Keep users in users.json so they survive a restart
+	jsonStore, err := OpenJSONStore("users.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if existing, _ := jsonStore.List(); len(existing) == 0 {
+		for _, u := range sampleUsers {
+			if _, err := jsonStore.Create(u); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	store = jsonStore
 
 	// Demonstrate HTTP client
 	go func() {
@@ -293,13 +432,15 @@ func main() {
 	fmt.Println("   GET    http://localhost:8080/api/users")
 	fmt.Println("   GET    http://localhost:8080/api/users/1")
 	fmt.Println("   POST   http://localhost:8080/api/users/create")
+	fmt.Println("   PUT    http://localhost:8080/api/users/1")
+	fmt.Println("   PATCH  http://localhost:8080/api/users/1")
 	fmt.Println("   DELETE http://localhost:8080/api/users/1")
 	fmt.Println("\n💡 Try it with curl:")
 	fmt.Println("   curl http://localhost:8080/api/users")
-	fmt.Println(`   curl -X POST http://localhost:8080/api/users/create -H "Content-Type: application/json" -d '{"name":"Jane Doe","email":"jane@example.com"}'`)
+	fmt.Println(`   curl -X POST http://localhost:8080/api/users/create -H "Authorization: Bearer $API_TOKEN" -H "Content-Type: application/json" -d '{"name":"Jane Doe","email":"jane@example.com"}'`)
 	fmt.Println()
 
-	if err := http.ListenAndServe(port, nil); err != nil {
+	if err := http.ListenAndServe(port, newRouter()); err != nil {
 		log.Fatal(err)
 	}
 }
\ No newline at end of file
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNotJSONObject is returned by MaskFields for values that don't encode
+// to a JSON object (e.g. a plain string or a slice)
+var ErrNotJSONObject = errors.New("value does not encode to a JSON object")
+
+// maskedValue replaces the contents of every masked field
+const maskedValue = "***"
+
+// MaskFields encodes v as JSON with the named fields replaced by "***"
+// Field names are the JSON keys (e.g. "email"), not the Go field names.
+// Useful for logging structs without leaking personal data.
+func MaskFields(v any, fields ...string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode into a generic map so any struct can be handled
+	// UseNumber keeps large integers (like IDs) exact instead of float64
+	var object map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil || object == nil {
+		return nil, fmt.Errorf("%w: %T", ErrNotJSONObject, v)
+	}
+
+	for _, field := range fields {
+		if _, ok := object[field]; ok {
+			object[field] = maskedValue
+		}
+	}
+
+	return json.Marshal(object)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMaskFields(t *testing.T) {
+	user := User{
+		ID:        42,
+		Name:      "Jane Doe",
+		Email:     "jane@example.com",
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	data, err := MaskFields(user, "email")
+	if err != nil {
+		t.Fatalf("MaskFields returned error: %v", err)
+	}
+	if strings.Contains(string(data), "jane@example.com") {
+		t.Errorf("masked JSON still contains the email: %s", data)
+	}
+
+	var decoded User
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("masked JSON is not valid: %v", err)
+	}
+	if decoded.Email != "***" {
+		t.Errorf("Email = %q; expected %q", decoded.Email, "***")
+	}
+	if decoded.ID != user.ID || decoded.Name != user.Name || !decoded.CreatedAt.Equal(user.CreatedAt) {
+		t.Errorf("other fields changed: got %+v; expected %+v", decoded, user)
+	}
+}
+
+func TestMaskFieldsUnknownField(t *testing.T) {
+	data, err := MaskFields(User{Name: "Jane"}, "password")
+	if err != nil {
+		t.Fatalf("MaskFields returned error: %v", err)
+	}
+	if strings.Contains(string(data), "password") {
+		t.Errorf("unknown field should not be added: %s", data)
+	}
+}
+
+func TestMaskFieldsNotAnObject(t *testing.T) {
+	if _, err := MaskFields([]int{1, 2, 3}, "email"); !errors.Is(err, ErrNotJSONObject) {
+		t.Errorf("MaskFields(slice) error = %v; expected ErrNotJSONObject", err)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/xml"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// sendResponse writes response as XML if the client's Accept header asks
+// for XML, and as JSON otherwise (including when there is no Accept header)
+func sendResponse(w http.ResponseWriter, r *http.Request, statusCode int, response Response) {
+	sendResponseBody(w, r, statusCode, response)
+}
+
+// sendResponseBody negotiates the format for any body (a Response or an
+// ErrorResponse)
+func sendResponseBody(w http.ResponseWriter, r *http.Request, statusCode int, body any) {
+	if prefersXML(r.Header.Get("Accept")) {
+		sendXMLResponse(w, statusCode, body)
+		return
+	}
+	sendJSONResponse(w, statusCode, body)
+}
+
+func sendXMLResponse(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	w.Write([]byte(xml.Header)) // <?xml version="1.0" encoding="UTF-8"?>
+	xml.NewEncoder(w).Encode(body)
+}
+
+// prefersXML looks through an Accept header such as
+// "application/xml, application/json;q=0.9" and reports whether the first
+// type we can produce is XML. It keeps things simple by using the order
+// the client listed the types in rather than their q weights.
+func prefersXML(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "application/xml", "text/xml":
+			return true
+		case "application/json", "*/*":
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPrefersXML(t *testing.T) {
+	tests := []struct {
+		accept   string
+		expected bool
+	}{
+		{"", false},
+		{"application/json", false},
+		{"application/xml", true},
+		{"text/xml; charset=utf-8", true},
+		{"application/xml, application/json;q=0.9", true},
+		{"application/json, application/xml", false},
+		{"text/html, application/xml;q=0.9, */*;q=0.8", true},
+		{"*/*", false},
+	}
+
+	for _, tt := range tests {
+		if got := prefersXML(tt.accept); got != tt.expected {
+			t.Errorf("prefersXML(%q) = %v; expected %v", tt.accept, got, tt.expected)
+		}
+	}
+}
+
+func TestGetUserByIDAsXML(t *testing.T) {
+	withTestUsers(t)
+	req := httptest.NewRequest(http.MethodGet, "/api/users/1", nil)
+	req.Header.Set("Accept", "application/xml")
+	rec := httptest.NewRecorder()
+
+	newRouter().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d; expected %d", rec.Code, http.StatusOK)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/xml" {
+		t.Errorf("Content-Type = %q; expected application/xml", ct)
+	}
+
+	var resp struct {
+		XMLName xml.Name `xml:"response"`
+		Success bool     `xml:"success"`
+		Data    User     `xml:"data"`
+	}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("body is not valid XML: %v\n%s", err, rec.Body.String())
+	}
+	if !resp.Success || resp.Data.ID != 1 || resp.Data.Name != "Alice Johnson" {
+		t.Errorf("decoded response = %+v; expected Alice Johnson with ID 1", resp)
+	}
+}
+
+func TestGetUsersAsXMLIncludesPageMeta(t *testing.T) {
+	withTestUsers(t)
+	req := httptest.NewRequest(http.MethodGet, "/api/users?limit=1", nil)
+	req.Header.Set("Accept", "application/xml")
+	rec := httptest.NewRecorder()
+
+	getUsersHandler(rec, req)
+
+	var resp struct {
+		Data UserPage `xml:"data"`
+	}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("body is not valid XML: %v\n%s", err, rec.Body.String())
+	}
+	if len(resp.Data.Users) != 1 || resp.Data.Meta.Total != 2 {
+		t.Errorf("decoded page = %+v; expected 1 user out of 2", resp.Data)
+	}
+}
+
+func TestResponsesDefaultToJSON(t *testing.T) {
+	withTestUsers(t)
+	rec := httptest.NewRecorder()
+
+	newRouter().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/users/1", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q; expected application/json", ct)
+	}
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Errorf("body is not valid JSON: %v", err)
+	}
+}
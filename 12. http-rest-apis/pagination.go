@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Defaults used when the client doesn't send ?page= or ?limit=
+const (
+	defaultPage  = 1
+	defaultLimit = 20
+	maxLimit     = 100 // Larger limits are rejected with 400
+)
+
+// PageMeta describes where a page sits in the full list
+type PageMeta struct {
+	Page       int `json:"page" xml:"page"`
+	Limit      int `json:"limit" xml:"limit"`
+	Total      int `json:"total" xml:"total"`             // Number of items across all pages
+	TotalPages int `json:"total_pages" xml:"total_pages"` // 0 when there are no items
+}
+
+// UserPage is the Data of a paginated users response
+type UserPage struct {
+	Users []User   `json:"users" xml:"users>user"`
+	Meta  PageMeta `json:"meta" xml:"meta"`
+}
+
+// parsePageParams reads ?page= and ?limit= from the query string.
+// Missing values fall back to the defaults; values that aren't whole
+// numbers of at least 1, or a limit above maxLimit, are an error.
+func parsePageParams(r *http.Request) (page, limit int, err error) {
+	page, err = positiveQueryInt(r, "page", defaultPage)
+	if err != nil {
+		return 0, 0, err
+	}
+	limit, err = positiveQueryInt(r, "limit", defaultLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit > maxLimit {
+		return 0, 0, fmt.Errorf("limit must be at most %d, got %d", maxLimit, limit)
+	}
+	return page, limit, nil
+}
+
+func positiveQueryInt(r *http.Request, name string, def int) (int, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s must be a positive whole number, got %q", name, raw)
+	}
+	return n, nil
+}
+
+// paginate returns one page of users plus its metadata. A page past the end
+// is not an error - it just has no users.
+func paginate(all []User, page, limit int) UserPage {
+	total := len(all)
+	totalPages := total / limit
+	if total%limit != 0 {
+		totalPages++ // Round up for the last partial page
+	}
+
+	// Check before multiplying: (page-1)*limit overflows for a huge page
+	start, end := total, total
+	if page <= totalPages {
+		start = (page - 1) * limit
+		end = min(start+limit, total)
+	}
+
+	return UserPage{
+		Users: all[start:end],
+		Meta: PageMeta{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}
+}
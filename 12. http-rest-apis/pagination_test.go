@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// makeUsers returns n users with IDs 1..n
+func makeUsers(n int) []User {
+	list := make([]User, n)
+	for i := range list {
+		list[i] = User{ID: i + 1, Name: "User", Email: "user@example.com"}
+	}
+	return list
+}
+
+// getUserPage calls getUsersHandler with the given query string
+func getUserPage(t *testing.T, query string) (int, UserPage) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/users"+query, nil)
+	rec := httptest.NewRecorder()
+
+	getUsersHandler(rec, req)
+
+	var resp struct {
+		Data UserPage `json:"data"`
+	}
+	if rec.Code == http.StatusOK {
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("could not decode response: %v", err)
+		}
+	}
+	return rec.Code, resp.Data
+}
+
+func TestGetUsersHandlerPagination(t *testing.T) {
+	withStore(t, makeUsers(25)...)
+
+	tests := []struct {
+		name       string
+		query      string
+		firstID    int
+		count      int
+		page       int
+		limit      int
+		totalPages int
+	}{
+		{"defaults", "", 1, 20, 1, 20, 2},
+		{"second page", "?page=2&limit=10", 11, 10, 2, 10, 3},
+		{"last partial page", "?page=3&limit=10", 21, 5, 3, 10, 3},
+		{"past the end", "?page=4&limit=10", 0, 0, 4, 10, 3},
+		{"limit larger than total", "?limit=100", 1, 25, 1, 100, 1},
+		{"huge page", "?page=4611686018427387904&limit=4", 0, 0, 4611686018427387904, 4, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, got := getUserPage(t, tt.query)
+
+			if code != http.StatusOK {
+				t.Fatalf("status = %d; expected %d", code, http.StatusOK)
+			}
+			if len(got.Users) != tt.count {
+				t.Errorf("got %d users; expected %d", len(got.Users), tt.count)
+			}
+			if tt.count > 0 && got.Users[0].ID != tt.firstID {
+				t.Errorf("first ID = %d; expected %d", got.Users[0].ID, tt.firstID)
+			}
+
+			expectedMeta := PageMeta{Page: tt.page, Limit: tt.limit, Total: 25, TotalPages: tt.totalPages}
+			if got.Meta != expectedMeta {
+				t.Errorf("meta = %+v; expected %+v", got.Meta, expectedMeta)
+			}
+		})
+	}
+}
+
+func TestGetUsersHandlerInvalidPageParams(t *testing.T) {
+	for _, query := range []string{"?page=-1", "?limit=-5", "?page=0", "?limit=abc", "?limit=101", "?limit=9223372036854775807"} {
+		t.Run(query, func(t *testing.T) {
+			if code, _ := getUserPage(t, query); code != http.StatusBadRequest {
+				t.Errorf("status = %d; expected %d", code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestPaginateEmptyList(t *testing.T) {
+	got := paginate([]User{}, 1, 20)
+
+	if len(got.Users) != 0 || got.Meta.Total != 0 || got.Meta.TotalPages != 0 {
+		t.Errorf("paginate(empty) = %+v; expected no users and zero totals", got)
+	}
+}
+
+func TestPaginateHugeValues(t *testing.T) {
+	// Neither page*limit nor total+limit may overflow
+	got := paginate(makeUsers(5), 1, math.MaxInt)
+	if len(got.Users) != 5 || got.Meta.TotalPages != 1 {
+		t.Errorf("paginate(5 users, 1, MaxInt) = %d users, %d pages; expected 5 users, 1 page", len(got.Users), got.Meta.TotalPages)
+	}
+
+	got = paginate(makeUsers(5), math.MaxInt, 2)
+	if len(got.Users) != 0 || got.Meta.TotalPages != 3 {
+		t.Errorf("paginate(5 users, MaxInt, 2) = %d users, %d pages; expected 0 users, 3 pages", len(got.Users), got.Meta.TotalPages)
+	}
+}
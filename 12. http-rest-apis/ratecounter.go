@@ -0,0 +1,61 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// SlidingWindowCounter counts events that happened within a recent time window
+// e.g. "how many requests did this client make in the last second?"
+// It is safe to use from multiple goroutines (every handler runs in its own).
+type SlidingWindowCounter struct {
+	mu     sync.Mutex
+	window time.Duration    // How long events are remembered
+	events []time.Time      // Event times, oldest first
+	now    func() time.Time // Clock, replaceable in tests
+}
+
+// NewSlidingWindowCounter creates a counter that remembers events for window
+func NewSlidingWindowCounter(window time.Duration) *SlidingWindowCounter {
+	return &SlidingWindowCounter{
+		window: window,
+		now:    time.Now,
+	}
+}
+
+// Record adds an event at the current time
+func (c *SlidingWindowCounter) Record() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.prune(now)
+	c.events = append(c.events, now)
+}
+
+// CountInLast returns how many events happened within the last d
+// d is capped at the counter's window, since older events are discarded
+func (c *SlidingWindowCounter) CountInLast(d time.Duration) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.prune(now)
+	return len(c.events) - c.indexAfter(now.Add(-d))
+}
+
+// prune drops events that are older than the window
+// The caller must hold c.mu
+func (c *SlidingWindowCounter) prune(now time.Time) {
+	cutoff := c.indexAfter(now.Add(-c.window))
+	c.events = c.events[cutoff:]
+}
+
+// indexAfter returns the index of the first event after t
+// Events are stored in time order, so a binary search works
+func (c *SlidingWindowCounter) indexAfter(t time.Time) int {
+	return sort.Search(len(c.events), func(i int) bool {
+		return c.events[i].After(t)
+	})
+}
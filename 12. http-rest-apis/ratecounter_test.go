@@ -0,0 +1,73 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock lets tests move time forward without sleeping
+type fakeClock struct {
+	current time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.current
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.current = f.current.Add(d)
+}
+
+func TestSlidingWindowCounter(t *testing.T) {
+	clock := &fakeClock{current: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	counter := NewSlidingWindowCounter(time.Minute)
+	counter.now = clock.Now
+
+	// Three events, 10 seconds apart: at 0s, 10s, 20s
+	for i := 0; i < 3; i++ {
+		counter.Record()
+		clock.Advance(10 * time.Second)
+	}
+	// Now at 30s
+
+	if got := counter.CountInLast(time.Minute); got != 3 {
+		t.Errorf("CountInLast(1m) = %d; expected 3", got)
+	}
+	if got := counter.CountInLast(15 * time.Second); got != 1 {
+		t.Errorf("CountInLast(15s) = %d; expected 1 (only the event at 20s)", got)
+	}
+
+	// Slide forward: at 65s the event at 0s has left the window
+	clock.Advance(35 * time.Second)
+	if got := counter.CountInLast(time.Minute); got != 2 {
+		t.Errorf("CountInLast(1m) after 65s = %d; expected 2", got)
+	}
+
+	// At 90s everything has expired
+	clock.Advance(25 * time.Second)
+	if got := counter.CountInLast(time.Minute); got != 0 {
+		t.Errorf("CountInLast(1m) after 90s = %d; expected 0", got)
+	}
+	if len(counter.events) != 0 {
+		t.Errorf("expected old events to be pruned, %d remain", len(counter.events))
+	}
+}
+
+func TestSlidingWindowCounterConcurrent(t *testing.T) {
+	counter := NewSlidingWindowCounter(time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counter.Record()
+		}()
+	}
+	wg.Wait()
+
+	if got := counter.CountInLast(time.Minute); got != 50 {
+		t.Errorf("CountInLast(1m) = %d; expected 50", got)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// How often idle buckets are swept away, and how long a client must be
+// quiet before its bucket is removed
+const (
+	bucketCleanupInterval = time.Minute
+	bucketIdleTimeout     = 3 * time.Minute
+)
+
+// tokenBucket holds up to burst tokens and gains rate tokens per second.
+// Each request spends one token; with none left the request is refused.
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time // When tokens was last refilled
+}
+
+// IPRateLimiter keeps one token bucket per client IP.
+// It is safe to use from multiple goroutines.
+type IPRateLimiter struct {
+	mu          sync.Mutex
+	rate        float64 // Tokens added per second
+	burst       float64 // Bucket size: how many requests can arrive at once
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+	now         func() time.Time // Clock, replaceable in tests
+}
+
+// NewIPRateLimiter allows each IP perSecond requests per second on average,
+// with bursts of up to burst requests
+func NewIPRateLimiter(perSecond float64, burst int) *IPRateLimiter {
+	return &IPRateLimiter{
+		rate:    perSecond,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// Allow spends a token from ip's bucket. If the bucket is empty it returns
+// false and how long until the next token is available.
+func (l *IPRateLimiter) Allow(ip string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	bucket, ok := l.buckets[ip]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, lastSeen: now} // New clients start full
+		l.buckets[ip] = bucket
+	}
+
+	// Refill for the time since we last looked, but never above burst
+	elapsed := now.Sub(bucket.lastSeen).Seconds()
+	bucket.tokens = math.Min(l.burst, bucket.tokens+elapsed*l.rate)
+	bucket.lastSeen = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+
+	missing := 1 - bucket.tokens
+	return false, time.Duration(missing / l.rate * float64(time.Second))
+}
+
+// cleanup removes buckets that haven't been used for a while, so the map
+// doesn't grow forever. It only sweeps once per bucketCleanupInterval.
+// The caller must hold l.mu
+func (l *IPRateLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < bucketCleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+
+	for ip, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) > bucketIdleTimeout {
+			delete(l.buckets, ip) // Deleting while ranging is allowed in Go
+		}
+	}
+}
+
+// clientIP returns the IP part of r.RemoteAddr ("203.0.113.7:52100").
+// Behind a proxy every request would share the proxy's IP; a real server
+// would then trust a header such as X-Forwarded-For from that proxy only.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr // No port - use it as is
+	}
+	return host
+}
+
+// Rate limit middleware: answers 429 Too Many Requests, with a Retry-After
+// header in whole seconds, once a client IP runs out of tokens
+func rateLimitMiddleware(limiter *IPRateLimiter, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		allowed, wait := limiter.Allow(clientIP(r))
+		if !allowed {
+			seconds := int(math.Ceil(wait.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+			sendError(w, r, http.StatusTooManyRequests, CodeRateLimited, "Too many requests, slow down")
+			return
+		}
+
+		next(w, r)
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIPRateLimiterBurstAndRefill(t *testing.T) {
+	clock := &fakeClock{current: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := NewIPRateLimiter(10, 20)
+	limiter.now = clock.Now
+
+	// A full bucket allows a burst of 20
+	for i := 0; i < 20; i++ {
+		if ok, _ := limiter.Allow("10.0.0.1"); !ok {
+			t.Fatalf("request %d was refused; expected the first 20 to pass", i+1)
+		}
+	}
+
+	ok, wait := limiter.Allow("10.0.0.1")
+	if ok {
+		t.Fatal("request 21 was allowed; expected the bucket to be empty")
+	}
+	if wait != 100*time.Millisecond {
+		t.Errorf("wait = %v; expected 100ms (one token at 10/sec)", wait)
+	}
+
+	// Other clients have their own bucket
+	if ok, _ := limiter.Allow("10.0.0.2"); !ok {
+		t.Error("a different IP was refused; expected separate buckets")
+	}
+
+	// After 300ms, 3 tokens have been added back
+	clock.Advance(300 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if ok, _ := limiter.Allow("10.0.0.1"); !ok {
+			t.Errorf("request %d after refill was refused", i+1)
+		}
+	}
+	if ok, _ := limiter.Allow("10.0.0.1"); ok {
+		t.Error("expected the 4th request after a 300ms refill to be refused")
+	}
+}
+
+func TestIPRateLimiterCleanup(t *testing.T) {
+	clock := &fakeClock{current: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := NewIPRateLimiter(10, 20)
+	limiter.now = clock.Now
+
+	limiter.Allow("10.0.0.1")
+	clock.Advance(bucketIdleTimeout + bucketCleanupInterval)
+	limiter.Allow("10.0.0.2") // Triggers the sweep
+
+	if _, ok := limiter.buckets["10.0.0.1"]; ok {
+		t.Error("idle bucket for 10.0.0.1 was not cleaned up")
+	}
+	if len(limiter.buckets) != 1 {
+		t.Errorf("bucket count = %d; expected 1", len(limiter.buckets))
+	}
+}
+
+func TestRateLimitMiddlewareReturns429(t *testing.T) {
+	handler := rateLimitMiddleware(NewIPRateLimiter(10, 20), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	limited := 0
+	for i := 0; i < 50; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/users", nil)
+		req.RemoteAddr = "203.0.113.7:52100"
+		rec := httptest.NewRecorder()
+
+		handler(rec, req)
+
+		if rec.Code == http.StatusTooManyRequests {
+			limited++
+			if rec.Header().Get("Retry-After") == "" {
+				t.Error("429 response has no Retry-After header")
+			}
+		}
+	}
+
+	// 50 requests arrive far faster than 10/sec, so most past the burst are refused
+	if limited == 0 {
+		t.Error("no requests were rate limited; expected some 429 responses")
+	}
+	if limited > 30 {
+		t.Errorf("%d requests were limited; expected the first 20 to pass", limited)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		remoteAddr string
+		expected   string
+	}{
+		{"203.0.113.7:52100", "203.0.113.7"},
+		{"[2001:db8::1]:443", "2001:db8::1"},
+		{"203.0.113.7", "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.remoteAddr
+		if got := clientIP(req); got != tt.expected {
+			t.Errorf("clientIP(%q) = %q; expected %q", tt.remoteAddr, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// requestIDHeader carries the ID in both the request and the response
+const requestIDHeader = "X-Request-ID"
+
+// requestIDKey is the context key for the request ID. Using our own
+// unexported type means no other package can clash with (or read) the key.
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID stored by requestIDMiddleware,
+// or "" if there is none
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRequestID returns a random ID in UUID version 4 format,
+// e.g. "3f0c9a4e-8b1d-4c2a-9e5f-0a1b2c3d4e5f"
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])             // Never returns an error
+	b[6] = (b[6] & 0x0f) | 0x40 // Version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// validRequestID accepts short IDs made of letters, digits, '-' and '_'.
+// Anything else from the client is replaced, so it can't mess up the logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+	for _, c := range id {
+		isAlnum := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if !isAlnum && c != '-' && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// Request ID middleware: gives every request an ID (reusing the client's
+// X-Request-ID if it sent a sensible one), stores it in the request context
+// and echoes it back in the response header so both sides can match logs
+func requestIDMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+
+		w.Header().Set(requestIDHeader, id)
+		ctx := context.WithValue(r.Context(), requestIDKey{}, id)
+		next(w, r.WithContext(ctx))
+	}
+}
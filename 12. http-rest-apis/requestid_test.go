@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+// captureLogs sends the standard logger's output to a buffer for one test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	original := log.Writer()
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(original) })
+	return &buf
+}
+
+func TestRequestIDMiddlewareGeneratesID(t *testing.T) {
+	logs := captureLogs(t)
+	var seen string
+	handler := requestIDMiddleware(loggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/api/users", nil))
+
+	id := rec.Header().Get(requestIDHeader)
+	if !uuidPattern.MatchString(id) {
+		t.Fatalf("%s header = %q; expected a UUID-like ID", requestIDHeader, id)
+	}
+	if seen != id {
+		t.Errorf("RequestIDFromContext = %q; expected the header value %q", seen, id)
+	}
+	if !strings.Contains(logs.String(), "["+id+"] Started GET /api/users") {
+		t.Errorf("log output %q does not contain the request ID %q", logs.String(), id)
+	}
+}
+
+func TestRequestIDMiddlewareReusesIncomingID(t *testing.T) {
+	handler := requestIDMiddleware(func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		name     string
+		incoming string
+		reused   bool
+	}{
+		{"sensible ID", "client-abc_123", true},
+		{"contains spaces", "bad id\nfake log line", false},
+		{"too long", strings.Repeat("a", 65), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(requestIDHeader, tt.incoming)
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			got := rec.Header().Get(requestIDHeader)
+			if (got == tt.incoming) != tt.reused {
+				t.Errorf("%s = %q; reused = %v, expected %v", requestIDHeader, got, got == tt.incoming, tt.reused)
+			}
+		})
+	}
+}
+
+func TestRequestIDFromContextEmpty(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if got := RequestIDFromContext(req.Context()); got != "" {
+		t.Errorf("RequestIDFromContext = %q; expected empty string", got)
+	}
+}
+
+func TestRouterSetsRequestID(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newRouter().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/users", nil))
+
+	if rec.Header().Get(requestIDHeader) == "" {
+		t.Errorf("router response has no %s header", requestIDHeader)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouterPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected int
+	}{
+		{"list users", http.MethodGet, "/api/users", http.StatusOK},
+		{"user by ID", http.MethodGet, "/api/users/1", http.StatusOK},
+		{"unknown ID", http.MethodGet, "/api/users/99", http.StatusNotFound},
+		{"non-numeric ID", http.MethodGet, "/api/users/abc", http.StatusBadRequest},
+		{"extra path segment", http.MethodGet, "/api/users/1/extra", http.StatusNotFound},
+		{"trailing slash without ID", http.MethodGet, "/api/users/", http.StatusNotFound},
+		{"unknown path", http.MethodGet, "/api/nothing", http.StatusNotFound},
+		{"wrong method", http.MethodPost, "/api/users/1", http.StatusMethodNotAllowed},
+		{"delete non-numeric ID", http.MethodDelete, "/api/users/abc", http.StatusBadRequest},
+		{"delete user", http.MethodDelete, "/api/users/2", http.StatusOK},
+		{"CORS preflight", http.MethodOptions, "/api/users/1", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTestUsers(t)
+			req := authorize(httptest.NewRequest(tt.method, tt.path, nil))
+			rec := httptest.NewRecorder()
+
+			newRouter().ServeHTTP(rec, req)
+
+			if rec.Code != tt.expected {
+				t.Errorf("%s %s: status = %d; expected %d", tt.method, tt.path, rec.Code, tt.expected)
+			}
+		})
+	}
+}
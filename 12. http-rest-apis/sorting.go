@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// userLess holds a "less than" function for each field ?sort= accepts
+var userLess = map[string]func(a, b User) bool{
+	"id":    func(a, b User) bool { return a.ID < b.ID },
+	"name":  func(a, b User) bool { return strings.ToLower(a.Name) < strings.ToLower(b.Name) },
+	"email": func(a, b User) bool { return strings.ToLower(a.Email) < strings.ToLower(b.Email) },
+}
+
+// sortedUsers returns a sorted copy of list, ordered by the ?sort= field
+// (default "id") in the ?order= direction ("asc" by default, or "desc").
+// The original slice is left untouched.
+func sortedUsers(r *http.Request, list []User) ([]User, error) {
+	query := r.URL.Query()
+
+	field := query.Get("sort")
+	if field == "" {
+		field = "id"
+	}
+	less, ok := userLess[field]
+	if !ok {
+		return nil, fmt.Errorf("cannot sort by %q (use id, name or email)", field)
+	}
+
+	order := query.Get("order")
+	if order == "" {
+		order = "asc"
+	}
+	if order != "asc" && order != "desc" {
+		return nil, fmt.Errorf("order must be asc or desc, got %q", order)
+	}
+
+	// Copy first: sort.Slice works in place and would reorder the stored users
+	sorted := make([]User, len(list))
+	copy(sorted, list)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if order == "desc" {
+			a, b = b, a
+		}
+		if less(a, b) || less(b, a) {
+			return less(a, b)
+		}
+		return sorted[i].ID < sorted[j].ID // Equal values: keep a stable order by ID
+	})
+	return sorted, nil
+}
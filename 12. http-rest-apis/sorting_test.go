@@ -0,0 +1,67 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetUsersHandlerSorting(t *testing.T) {
+	withStore(t,
+		User{ID: 1, Name: "Charlie", Email: "b@example.com"},
+		User{ID: 2, Name: "alice", Email: "c@example.com"},
+		User{ID: 3, Name: "Bob", Email: "a@example.com"},
+	)
+
+	tests := []struct {
+		query       string
+		expectedIDs []int
+	}{
+		{"", []int{1, 2, 3}},
+		{"?sort=id&order=desc", []int{3, 2, 1}},
+		{"?sort=name", []int{2, 3, 1}}, // Case-insensitive: alice, Bob, Charlie
+		{"?sort=name&order=desc", []int{1, 3, 2}},
+		{"?sort=email", []int{3, 1, 2}},
+		{"?sort=email&order=desc", []int{2, 1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			code, page := getUserPage(t, tt.query)
+			if code != http.StatusOK {
+				t.Fatalf("status = %d; expected %d", code, http.StatusOK)
+			}
+
+			var ids []int
+			for _, u := range page.Users {
+				ids = append(ids, u.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("IDs = %v; expected %v", ids, tt.expectedIDs)
+			}
+		})
+	}
+
+	if list, _ := store.List(); list[0].ID != 1 {
+		t.Error("sorting reordered the stored users; expected a sorted copy")
+	}
+}
+
+func TestGetUsersHandlerInvalidSort(t *testing.T) {
+	for _, query := range []string{"?sort=created_at", "?sort=name&order=sideways"} {
+		t.Run(query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/users"+query, nil)
+			rec := httptest.NewRecorder()
+
+			getUsersHandler(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d; expected %d", rec.Code, http.StatusBadRequest)
+			}
+			if resp := decodeError(t, rec); resp.Code != CodeInvalidQuery || resp.Message == "" {
+				t.Errorf("response = %+v; expected code %q with a message", resp, CodeInvalidQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrUserNotFound is returned when no user has the requested ID
+var ErrUserNotFound = errors.New("user not found")
+
+// UserStore is where the handlers keep users. Hiding storage behind an
+// interface lets us swap the in-memory version for a file (or a real
+// database) without touching the handlers.
+type UserStore interface {
+	List() ([]User, error)
+	Get(id int) (User, error)
+	Create(u User) (User, error) // Assigns ID and CreatedAt
+	Update(u User) error         // Replaces the user with the same ID
+	Delete(id int) error
+}
+
+// MemoryStore keeps users in a slice. Everything is lost on restart.
+// It is safe to use from multiple goroutines (every request runs in its own).
+type MemoryStore struct {
+	mu     sync.RWMutex
+	users  []User
+	nextID int
+}
+
+// NewMemoryStore creates a store holding the given users.
+// New users get IDs after the highest one in seed.
+func NewMemoryStore(seed ...User) *MemoryStore {
+	s := &MemoryStore{users: slices.Clone(seed), nextID: 1}
+	for _, u := range seed {
+		s.nextID = max(s.nextID, u.ID+1)
+	}
+	return s
+}
+
+// List returns a copy of every user, so callers can sort it safely
+func (s *MemoryStore) List() ([]User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return slices.Clone(s.users), nil
+}
+
+func (s *MemoryStore) Get(id int) (User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	i := s.indexOf(id)
+	if i < 0 {
+		return User{}, ErrUserNotFound
+	}
+	return s.users[i], nil
+}
+
+func (s *MemoryStore) Create(u User) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u.ID = s.nextID
+	s.nextID++
+	u.CreatedAt = time.Now()
+	s.users = append(s.users, u)
+	return u, nil
+}
+
+func (s *MemoryStore) Update(u User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.indexOf(u.ID)
+	if i < 0 {
+		return ErrUserNotFound
+	}
+	s.users[i] = u
+	return nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.indexOf(id)
+	if i < 0 {
+		return ErrUserNotFound
+	}
+	s.users = slices.Delete(s.users, i, i+1)
+	return nil
+}
+
+// indexOf returns the position of the user with id, or -1
+// The caller must hold s.mu
+func (s *MemoryStore) indexOf(id int) int {
+	return slices.IndexFunc(s.users, func(u User) bool { return u.ID == id })
+}
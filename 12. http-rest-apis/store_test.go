@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore(User{ID: 5, Name: "Seed", Email: "seed@example.com"})
+
+	created, err := s.Create(User{Name: "Jane", Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created.ID != 6 {
+		t.Errorf("new ID = %d; expected 6 (after the highest seed ID)", created.ID)
+	}
+	if created.CreatedAt.IsZero() {
+		t.Error("CreatedAt was not set")
+	}
+
+	created.Email = "jane@new.example.com"
+	if err := s.Update(created); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if got, _ := s.Get(6); got.Email != "jane@new.example.com" {
+		t.Errorf("Email after Update = %q", got.Email)
+	}
+
+	if err := s.Delete(5); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if list, _ := s.List(); len(list) != 1 {
+		t.Errorf("List after Delete has %d users; expected 1", len(list))
+	}
+
+	if _, err := s.Get(5); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Get(deleted) error = %v; expected ErrUserNotFound", err)
+	}
+	if err := s.Update(User{ID: 99}); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Update(missing) error = %v; expected ErrUserNotFound", err)
+	}
+	if err := s.Delete(99); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Delete(missing) error = %v; expected ErrUserNotFound", err)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validation errors for User fields
+// Callers can check for a specific problem with errors.Is
+var (
+	ErrMissingName  = errors.New("name is required")
+	ErrMissingEmail = errors.New("email is required")
+	ErrInvalidEmail = errors.New("email must be a valid address like name@example.com")
+	ErrNameTooLong  = errors.New("name must be at most 100 characters")
+)
+
+// maxNameLength is the longest name accepted, counted in characters (runes)
+const maxNameLength = 100
+
+// emailPattern is deliberately simple: something@something.tld with no
+// spaces. Fully validating an address needs more than a regexp.
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// FieldError says which field failed and why
+// Use errors.As to get at the Field name
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+// Unwrap lets errors.Is see the sentinel error inside
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateUserAll runs every field check and reports all failures at once,
+// combined with errors.Join. It returns nil when the user is valid.
+func ValidateUserAll(u User) error {
+	var errs []error
+
+	if strings.TrimSpace(u.Name) == "" {
+		errs = append(errs, &FieldError{Field: "name", Err: ErrMissingName})
+	} else if utf8.RuneCountInString(u.Name) > maxNameLength {
+		errs = append(errs, &FieldError{Field: "name", Err: ErrNameTooLong})
+	}
+
+	if strings.TrimSpace(u.Email) == "" {
+		errs = append(errs, &FieldError{Field: "email", Err: ErrMissingEmail})
+	} else if !emailPattern.MatchString(u.Email) {
+		errs = append(errs, &FieldError{Field: "email", Err: ErrInvalidEmail})
+	}
+
+	return errors.Join(errs...) // nil if errs is empty
+}
+
+// validateUser returns one message per invalid field, or nil if u is valid.
+// Handlers send the list back with 422 Unprocessable Entity: the JSON was
+// well-formed, but its contents break the rules.
+func validateUser(u User) []string {
+	if err := ValidateUserAll(u); err != nil {
+		return validationMessages(err)
+	}
+	return nil
+}
+
+// validationMessages splits an error from ValidateUserAll into one message
+// per problem, ready to send back to the client
+func validationMessages(err error) []string {
+	var messages []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			messages = append(messages, e.Error())
+		}
+		return messages
+	}
+	return []string{err.Error()}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateUserAllValid(t *testing.T) {
+	u := User{Name: "Jane Doe", Email: "jane@example.com"}
+
+	if err := ValidateUserAll(u); err != nil {
+		t.Errorf("ValidateUserAll(%+v) = %v; expected nil", u, err)
+	}
+}
+
+func TestValidateUserAllJoinsErrors(t *testing.T) {
+	err := ValidateUserAll(User{})
+
+	if !errors.Is(err, ErrMissingName) {
+		t.Errorf("expected ErrMissingName in %v", err)
+	}
+	if !errors.Is(err, ErrMissingEmail) {
+		t.Errorf("expected ErrMissingEmail in %v", err)
+	}
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("expected a *FieldError in %v", err)
+	}
+	if fieldErr.Field != "name" {
+		t.Errorf("first FieldError.Field = %q; expected %q", fieldErr.Field, "name")
+	}
+
+	if messages := validationMessages(err); len(messages) != 2 {
+		t.Errorf("validationMessages = %v; expected 2 messages", messages)
+	}
+}
+
+func TestValidateUserAllInvalidEmail(t *testing.T) {
+	err := ValidateUserAll(User{Name: "Jane", Email: "jane.example.com"})
+
+	if !errors.Is(err, ErrInvalidEmail) {
+		t.Errorf("expected ErrInvalidEmail, got %v", err)
+	}
+	if errors.Is(err, ErrMissingName) {
+		t.Errorf("did not expect ErrMissingName in %v", err)
+	}
+}
+
+func TestCreateUserHandlerReportsAllProblems(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/api/users/create", strings.NewReader(`{}`))
+	rec := httptest.NewRecorder()
+
+	createUserHandler(rec, req)
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d; expected %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	resp := decodeError(t, rec)
+	if resp.Code != CodeValidationFailed {
+		t.Errorf("code = %q; expected %q", resp.Code, CodeValidationFailed)
+	}
+	if len(resp.Details) != 2 {
+		t.Errorf("details = %v; expected both the name and email problems", resp.Details)
+	}
+}
+
+func TestValidateUser(t *testing.T) {
+	tests := []struct {
+		name          string
+		user          User
+		expectedCount int
+	}{
+		{"valid", User{Name: "Jane Doe", Email: "jane@example.com"}, 0},
+		{"name at the limit", User{Name: strings.Repeat("a", 100), Email: "jane@example.com"}, 0},
+		{"name too long", User{Name: strings.Repeat("a", 101), Email: "jane@example.com"}, 1},
+		{"multi-byte name at the limit", User{Name: strings.Repeat("é", 100), Email: "jane@example.com"}, 0},
+		{"email without @", User{Name: "Jane", Email: "jane.example.com"}, 1},
+		{"email without domain dot", User{Name: "Jane", Email: "jane@example"}, 1},
+		{"email with space", User{Name: "Jane", Email: "jane doe@example.com"}, 1},
+		{"email with two @", User{Name: "Jane", Email: "jane@@example.com"}, 1},
+		{"too long and malformed", User{Name: strings.Repeat("a", 101), Email: "nope"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateUser(tt.user)
+			if len(got) != tt.expectedCount {
+				t.Errorf("validateUser = %v; expected %d problems", got, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestCreateUserHandlerRejectsLongNameAndBadEmail(t *testing.T) {
+	withTestUsers(t)
+	body := `{"name":"` + strings.Repeat("x", 101) + `","email":"not-an-email"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/users/create", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	createUserHandler(rec, req)
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status = %d; expected %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	resp := decodeError(t, rec)
+	if resp.Code != CodeValidationFailed {
+		t.Errorf("code = %q; expected %q", resp.Code, CodeValidationFailed)
+	}
+	expected := []string{"name: " + ErrNameTooLong.Error(), "email: " + ErrInvalidEmail.Error()}
+	if !reflect.DeepEqual(resp.Details, expected) {
+		t.Errorf("details = %v; expected %v", resp.Details, expected)
+	}
+	if count := userCount(t); count != 2 {
+		t.Errorf("user count = %d; expected the invalid user not to be stored", count)
+	}
+}
@@ -0,0 +1,3 @@
+module types-and-variables
+
+go 1.23.0
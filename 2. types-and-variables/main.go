@@ -1,9 +1,15 @@
 package main
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"types-and-variables/units"
+)
 
 func main() {
-	fmt.Println("=== Go Basic Types and Variables ===\n")
+	fmt.Println("=== Go Basic Types and Variables ===")
+	fmt.Println()
 
 	// 1. STRING TYPE
 	fmt.Println("1. STRING TYPE:")
@@ -100,5 +106,30 @@ func main() {
 	fmt.Printf("Default float: %f\n", defaultFloat)
 	fmt.Printf("Default bool: %t\n", defaultBool)
 
+	// 9. PARSING AND CHECKED CONVERSION
+	fmt.Println("\n9. PARSING AND CHECKED CONVERSION:")
+	for _, input := range []string{"100", "300", "ten"} {
+		n, err := ParseIntSafe(input, 8) // Must fit in an int8
+		if err != nil {
+			fmt.Println("Error:", err)
+			continue
+		}
+		fmt.Printf("Parsed %q as %d\n", input, n)
+	}
+
+	bigValue := 200
+	fmt.Println("int8(200) silently wraps to:", int8(bigValue))
+	if _, err := SafeIntToInt8(bigValue); errors.Is(err, ErrOverflow) {
+		fmt.Println("SafeIntToInt8(200) refuses:", err)
+	}
+
+	// 10. USING ANOTHER PACKAGE
+	fmt.Println("\n10. USING ANOTHER PACKAGE (units):")
+	// units works with float64, so the float32 temperature is converted first
+	celsius := float64(temperature)
+	fmt.Printf("%.1f°C = %.1f°F\n", celsius, units.CelsiusToFahrenheit(celsius))
+	fmt.Printf("%.1f°C = %.2fK\n", celsius, units.CelsiusToKelvin(celsius))
+	fmt.Printf("98.6°F = %.1f°C\n", units.FahrenheitToCelsius(98.6))
+
 	fmt.Println("\n=== Program Complete ===")
 }
\ No newline at end of file
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ErrOverflow means a number is too big or too small for the target type
+var ErrOverflow = errors.New("value out of range")
+
+// ParseIntSafe parses s as a whole number that must fit in a signed
+// integer of the given size (8, 16, 32 or 64 bits; 0 means int). It wraps
+// strconv.ParseInt with errors that say what went wrong in plain words;
+// use errors.Is with ErrOverflow or strconv.ErrSyntax to check which.
+func ParseIntSafe(s string, bits int) (int64, error) {
+	n, err := strconv.ParseInt(s, 10, bits)
+	if err == nil {
+		return n, nil
+	}
+
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		switch numErr.Err {
+		case strconv.ErrRange:
+			// ParseInt returns the nearest limit here; don't pass that on
+			return 0, fmt.Errorf("%q does not fit in an %s: %w", s, intTypeName(bits), ErrOverflow)
+		case strconv.ErrSyntax:
+			return 0, fmt.Errorf("%q is not a whole number: %w", s, strconv.ErrSyntax)
+		}
+	}
+	return 0, err // e.g. an invalid bit size
+}
+
+// intTypeName names the type that ParseIntSafe's bits argument stands for
+func intTypeName(bits int) string {
+	if bits == 0 {
+		return "int" // 0 means "the size of int": 32 or 64 bits
+	}
+	return fmt.Sprintf("int%d", bits)
+}
+
+// SafeIntToInt8 converts n to an int8. A plain int8(n) silently wraps
+// around when n is outside -128..127 (int8(200) is -56); this returns
+// ErrOverflow instead.
+func SafeIntToInt8(n int) (int8, error) {
+	if n < math.MinInt8 || n > math.MaxInt8 {
+		return 0, fmt.Errorf("%d does not fit in an int8 (%d to %d): %w", n, math.MinInt8, math.MaxInt8, ErrOverflow)
+	}
+	return int8(n), nil
+}
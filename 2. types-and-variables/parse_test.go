@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestParseIntSafe(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		bits        int
+		expected    int64
+		expectedErr error
+	}{
+		{"positive", "42", 64, 42, nil},
+		{"negative", "-17", 64, -17, nil},
+		{"plus sign", "+5", 8, 5, nil},
+		{"int8 max", "127", 8, 127, nil},
+		{"int8 min", "-128", 8, -128, nil},
+		{"int8 overflow", "128", 8, 0, ErrOverflow},
+		{"int8 underflow", "-129", 8, 0, ErrOverflow},
+		{"int16 overflow", "40000", 16, 0, ErrOverflow},
+		{"int64 overflow", "9223372036854775808", 64, 0, ErrOverflow},
+		{"int size", "123", 0, 123, nil},
+		{"empty", "", 64, 0, strconv.ErrSyntax},
+		{"letters", "abc", 64, 0, strconv.ErrSyntax},
+		{"decimal", "3.14", 64, 0, strconv.ErrSyntax},
+		{"spaces", " 42", 64, 0, strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIntSafe(tt.input, tt.bits)
+
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("ParseIntSafe(%q, %d) error = %v; expected %v", tt.input, tt.bits, err, tt.expectedErr)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseIntSafe(%q, %d) = %d; expected %d", tt.input, tt.bits, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseIntSafeErrorMessages(t *testing.T) {
+	_, err := ParseIntSafe("300", 8)
+	if expected := `"300" does not fit in an int8: value out of range`; err == nil || err.Error() != expected {
+		t.Errorf("error = %v; expected %q", err, expected)
+	}
+
+	_, err = ParseIntSafe("12a", 32)
+	if expected := `"12a" is not a whole number: invalid syntax`; err == nil || err.Error() != expected {
+		t.Errorf("error = %v; expected %q", err, expected)
+	}
+}
+
+func TestSafeIntToInt8(t *testing.T) {
+	tests := []struct {
+		input       int
+		expected    int8
+		expectedErr error
+	}{
+		{0, 0, nil},
+		{127, 127, nil},
+		{-128, -128, nil},
+		{128, 0, ErrOverflow},
+		{-129, 0, ErrOverflow},
+		{200, 0, ErrOverflow}, // int8(200) would silently be -56
+		{1 << 40, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		got, err := SafeIntToInt8(tt.input)
+
+		if !errors.Is(err, tt.expectedErr) {
+			t.Errorf("SafeIntToInt8(%d) error = %v; expected %v", tt.input, err, tt.expectedErr)
+		}
+		if got != tt.expected {
+			t.Errorf("SafeIntToInt8(%d) = %d; expected %d", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+// Package units converts temperatures between Celsius, Fahrenheit and
+// Kelvin.
+//
+// All functions take and return float64. The formulas are exact, but
+// float64 itself can't store most decimals exactly (0.1 is really
+// 0.1000000000000000055...), so results can be off in the last of their
+// ~15 significant digits. Round for display, and compare results with a
+// small tolerance rather than ==.
+package units
+
+// AbsoluteZeroCelsius is 0 K expressed in degrees Celsius
+const AbsoluteZeroCelsius = -273.15
+
+// CelsiusToFahrenheit converts °C to °F: F = C × 9/5 + 32
+func CelsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
+
+// FahrenheitToCelsius converts °F to °C: C = (F − 32) × 5/9
+func FahrenheitToCelsius(f float64) float64 {
+	return (f - 32) * 5 / 9
+}
+
+// CelsiusToKelvin converts °C to K: K = C + 273.15. It doesn't check
+// for temperatures below absolute zero; those give a negative result.
+func CelsiusToKelvin(c float64) float64 {
+	return c - AbsoluteZeroCelsius
+}
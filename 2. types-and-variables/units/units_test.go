@@ -0,0 +1,80 @@
+package units
+
+import (
+	"math"
+	"testing"
+)
+
+// tolerance allows for float64 rounding in the last few digits
+const tolerance = 1e-9
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func TestCelsiusToFahrenheit(t *testing.T) {
+	tests := []struct {
+		celsius  float64
+		expected float64
+	}{
+		{0, 32},
+		{100, 212},
+		{-40, -40}, // The scales cross here
+		{37, 98.6},
+		{-273.15, -459.67},
+		{23.5, 74.3},
+	}
+
+	for _, tt := range tests {
+		if got := CelsiusToFahrenheit(tt.celsius); !almostEqual(got, tt.expected) {
+			t.Errorf("CelsiusToFahrenheit(%v) = %v; expected %v", tt.celsius, got, tt.expected)
+		}
+	}
+}
+
+func TestFahrenheitToCelsius(t *testing.T) {
+	tests := []struct {
+		fahrenheit float64
+		expected   float64
+	}{
+		{32, 0},
+		{212, 100},
+		{-40, -40},
+		{98.6, 37},
+		{-459.67, -273.15},
+		{0, -17.77777777777778},
+	}
+
+	for _, tt := range tests {
+		if got := FahrenheitToCelsius(tt.fahrenheit); !almostEqual(got, tt.expected) {
+			t.Errorf("FahrenheitToCelsius(%v) = %v; expected %v", tt.fahrenheit, got, tt.expected)
+		}
+	}
+}
+
+func TestCelsiusToKelvin(t *testing.T) {
+	tests := []struct {
+		celsius  float64
+		expected float64
+	}{
+		{-273.15, 0},
+		{AbsoluteZeroCelsius, 0},
+		{0, 273.15},
+		{100, 373.15},
+		{-300, -26.85}, // Below absolute zero isn't rejected
+	}
+
+	for _, tt := range tests {
+		if got := CelsiusToKelvin(tt.celsius); !almostEqual(got, tt.expected) {
+			t.Errorf("CelsiusToKelvin(%v) = %v; expected %v", tt.celsius, got, tt.expected)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, c := range []float64{-273.15, -40, 0, 0.1, 21.7, 100, 1e6} {
+		if got := FahrenheitToCelsius(CelsiusToFahrenheit(c)); !almostEqual(got, c) {
+			t.Errorf("FahrenheitToCelsius(CelsiusToFahrenheit(%v)) = %v; expected %v", c, got, c)
+		}
+	}
+}
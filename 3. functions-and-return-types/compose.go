@@ -0,0 +1,13 @@
+package main
+
+// Compose combines fs into one function that applies them right to left,
+// like maths notation: Compose(f, g)(x) is f(g(x)). Composing nothing
+// gives a function that returns its input unchanged.
+func Compose[T any](fs ...func(T) T) func(T) T {
+	return func(x T) T {
+		for i := len(fs) - 1; i >= 0; i-- {
+			x = fs[i](x)
+		}
+		return x
+	}
+}
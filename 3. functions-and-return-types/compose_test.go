@@ -0,0 +1,42 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompose(t *testing.T) {
+	increment := func(x int) int { return x + 1 }
+	double := func(x int) int { return x * 2 }
+
+	tests := []struct {
+		name     string
+		composed func(int) int
+		input    int
+		expected int
+	}{
+		{"increment after double", Compose(increment, double), 3, 7}, // (3*2)+1
+		{"double after increment", Compose(double, increment), 3, 8}, // (3+1)*2
+		{"single function", Compose(double), 5, 10},
+		{"same function twice", Compose(increment, increment), 0, 2},
+		{"empty is identity", Compose[int](), 42, 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.composed(tt.input); got != tt.expected {
+				t.Errorf("composed(%d) = %d; expected %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComposeStrings(t *testing.T) {
+	exclaim := func(s string) string { return s + "!" }
+
+	shout := Compose(exclaim, strings.ToUpper, strings.TrimSpace)
+
+	if got := shout("  hello "); got != "HELLO!" {
+		t.Errorf("shout(%q) = %q; expected %q", "  hello ", got, "HELLO!")
+	}
+}
@@ -0,0 +1,3 @@
+module functions-and-return-types
+
+go 1.23.0
@@ -1,9 +1,15 @@
 package main
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
 
 func main() {
-	fmt.Println("=== Go Functions and Return Types ===\n")
+	fmt.Println("=== Go Functions and Return Types ===")
+	fmt.Println()
 
 	// 1. BASIC FUNCTION CALL
 	fmt.Println("1. BASIC FUNCTION:")
@@ -74,6 +80,86 @@ func main() {
 	}(50, 30)
 	fmt.Println("50 - 30 =", result)
 
+	// 13. RECOVERING FROM PANICS
+	fmt.Println("\n13. RECOVERING FROM PANICS:")
+	err := SafeCall(func() {
+		panic("something went wrong")
+	})
+	fmt.Println("SafeCall returned:", err)
+	quotientValue, err := SafeCallR(func() int {
+		zero := 0
+		return 10 / zero // integer division by zero panics
+	})
+	fmt.Printf("SafeCallR returned: %d, %v\n", quotientValue, err)
+
+	// 14. RETRYING WITH A FUNCTION PARAMETER
+	fmt.Println("\n14. RETRYING ONLY TEMPORARY ERRORS:")
+	errBusy := errors.New("server busy")
+	attempts := 0
+	err = RetryIf(5, 10*time.Millisecond,
+		func(err error) bool { return errors.Is(err, errBusy) }, // Which errors are worth retrying
+		func() error {
+			attempts++
+			if attempts < 3 {
+				return errBusy
+			}
+			return nil
+		})
+	fmt.Printf("RetryIf finished after %d attempts, err: %v\n", attempts, err)
+
+	// 15. CACHING RESULTS (MEMOIZATION)
+	fmt.Println("\n15. MEMOIZATION WITH A SIZE LIMIT:")
+	slowSquare := func(n int) int {
+		fmt.Printf("  computing %d*%d...\n", n, n)
+		return n * n
+	}
+	cachedSquare := MemoizeLRU(2, slowSquare) // Remember at most 2 results
+	fmt.Println("square(4) =", cachedSquare(4))
+	fmt.Println("square(4) =", cachedSquare(4), "(cached)")
+	cachedSquare(5)
+	cachedSquare(6) // Cache is full: 4 is dropped
+	fmt.Println("square(4) =", cachedSquare(4), "(recomputed)")
+
+	// 16. MEMOIZING A RECURSIVE FUNCTION
+	fmt.Println("\n16. MEMOIZING A RECURSIVE FUNCTION:")
+	// Declare first so the function can call its memoized self
+	var memoFactorial func(int) int
+	memoFactorial = Memoize(func(n int) int {
+		fmt.Printf("  computing factorial(%d)\n", n)
+		if n <= 1 {
+			return 1
+		}
+		return n * memoFactorial(n-1)
+	})
+	fmt.Println("Factorial of 5:", memoFactorial(5))
+	fmt.Println("Factorial of 6:", memoFactorial(6), "(only 6 was new)")
+
+	// 17. COMPOSING FUNCTIONS
+	fmt.Println("\n17. COMPOSING FUNCTIONS:")
+	increment := func(x int) int { return x + 1 }
+	double := func(x int) int { return x * 2 }
+	incrementThenDouble := Compose(double, increment) // Applied right to left
+	fmt.Println("double(increment(3)) =", incrementThenDouble(3))
+
+	// 18. THROTTLING A FUNCTION
+	fmt.Println("\n18. THROTTLING A FUNCTION:")
+	handled := 0
+	onScroll := Throttle(func() { handled++ }, 50*time.Millisecond)
+	for range 20 { // 20 events, 10ms apart, over 200ms
+		onScroll()
+		time.Sleep(10 * time.Millisecond)
+	}
+	fmt.Printf("20 scroll events, %d handled (at most one per 50ms)\n", handled)
+
+	// 19. MUST: PANIC INSTEAD OF RETURNING AN ERROR
+	fmt.Println("\n19. MUST:")
+	port := Must(strconv.Atoi("8080")) // One expression instead of value, err := ...
+	fmt.Println("Port:", port)
+	err = SafeCall(func() {
+		Must(strconv.Atoi("eighty")) // Panics - SafeCall turns it back into an error
+	})
+	fmt.Println("Must with bad input:", err)
+
 	fmt.Println("\n=== Program Complete ===")
 }
 
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Memoize wraps f so each result is computed once and cached. Calling the
+// returned function again with the same key returns the cached value
+// without calling f. The cache is never emptied, so use MemoizeLRU when
+// there are many distinct keys.
+//
+// The returned function is safe to call from multiple goroutines.
+func Memoize[K comparable, V any](f func(K) V) func(K) V {
+	var mu sync.Mutex
+	cache := make(map[K]V)
+
+	return func(key K) V {
+		mu.Lock()
+		value, ok := cache[key]
+		mu.Unlock()
+		if ok {
+			return value
+		}
+
+		// Unlocked while computing, as in MemoizeLRU below
+		value = f(key)
+
+		mu.Lock()
+		cache[key] = value
+		mu.Unlock()
+		return value
+	}
+}
+
+// lruCache is a fixed-size cache that throws away the Least Recently Used
+// entry when it is full. The list keeps entries in usage order (front =
+// most recent) and the map finds an entry's list element in O(1).
+type lruCache[K comparable, V any] struct {
+	capacity int
+	order    *list.List          // Elements hold *lruEntry[K, V]
+	items    map[K]*list.Element // Key -> element in order
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRUCache[K comparable, V any](capacity int) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[K]*list.Element),
+	}
+}
+
+// get returns the cached value and marks it as recently used
+func (c *lruCache[K, V]) get(key K) (V, bool) {
+	element, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*lruEntry[K, V]).value, true
+}
+
+// put stores a value, evicting the least recently used entry if full
+func (c *lruCache[K, V]) put(key K, value V) {
+	if element, ok := c.items[key]; ok {
+		element.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(element)
+		return
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+}
+
+// MemoizeLRU wraps f so results are cached, but keeps at most capacity
+// results. When the cache is full, the result used longest ago is dropped
+// and recomputed if it is asked for again. It panics if capacity < 1.
+//
+// The returned function is safe to call from multiple goroutines.
+func MemoizeLRU[K comparable, V any](capacity int, f func(K) V) func(K) V {
+	if capacity < 1 {
+		panic("MemoizeLRU: capacity must be at least 1")
+	}
+
+	var mu sync.Mutex
+	cache := newLRUCache[K, V](capacity)
+
+	return func(key K) V {
+		mu.Lock()
+		value, ok := cache.get(key)
+		mu.Unlock()
+		if ok {
+			return value
+		}
+
+		// Don't hold the lock while computing: f might call the memoized
+		// function itself (recursion) and would deadlock
+		value = f(key)
+
+		mu.Lock()
+		cache.put(key, value)
+		mu.Unlock()
+		return value
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import "testing"
+
+func TestMemoizeCallsOncePerInput(t *testing.T) {
+	calls := make(map[string]int)
+	length := Memoize(func(s string) int {
+		calls[s]++
+		return len(s)
+	})
+
+	for _, s := range []string{"go", "gopher", "go", "go", "gopher", ""} {
+		if got := length(s); got != len(s) {
+			t.Errorf("length(%q) = %d; expected %d", s, got, len(s))
+		}
+	}
+
+	expected := map[string]int{"go": 1, "gopher": 1, "": 1}
+	for s, n := range expected {
+		if calls[s] != n {
+			t.Errorf("f called %d times for %q; expected %d", calls[s], s, n)
+		}
+	}
+}
+
+func TestMemoizeRecursive(t *testing.T) {
+	calls := 0
+	var factorial func(int) int
+	factorial = Memoize(func(n int) int {
+		calls++
+		if n <= 1 {
+			return 1
+		}
+		return n * factorial(n-1) // Goes through the cache too
+	})
+
+	if got := factorial(10); got != 3628800 {
+		t.Errorf("factorial(10) = %d; expected 3628800", got)
+	}
+	if calls != 10 {
+		t.Errorf("computed %d times; expected 10 (once per n from 1 to 10)", calls)
+	}
+
+	factorial(12) // Only 12 and 11 are new
+	if calls != 12 {
+		t.Errorf("computed %d times; expected 12", calls)
+	}
+}
+
+func TestMemoizeLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	calls := make(map[int]int)
+	square := MemoizeLRU(2, func(n int) int {
+		calls[n]++
+		return n * n
+	})
+
+	square(1) // cache: [1]
+	square(2) // cache: [2 1]
+	square(1) // hit - 1 is now the most recent: [1 2]
+	square(3) // full - evicts 2 (least recently used): [3 1]
+
+	if calls[1] != 1 || calls[2] != 1 || calls[3] != 1 {
+		t.Fatalf("calls = %v; expected each key computed once so far", calls)
+	}
+
+	// 1 stayed hot, so it's still cached
+	if got := square(1); got != 1 {
+		t.Errorf("square(1) = %d; expected 1", got)
+	}
+	if calls[1] != 1 {
+		t.Errorf("key 1 computed %d times; expected 1 (should still be cached)", calls[1])
+	}
+
+	// 2 was evicted, so it has to be recomputed
+	if got := square(2); got != 4 {
+		t.Errorf("square(2) = %d; expected 4", got)
+	}
+	if calls[2] != 2 {
+		t.Errorf("key 2 computed %d times; expected 2 (should have been evicted)", calls[2])
+	}
+}
+
+func TestMemoizeLRUInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected MemoizeLRU(0, ...) to panic")
+		}
+	}()
+
+	MemoizeLRU(0, func(n int) int { return n })
+}
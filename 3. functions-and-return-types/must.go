@@ -0,0 +1,16 @@
+package main
+
+// Must returns v, or panics with err if err is not nil. It turns a
+// (value, error) call into a single expression for values that can't
+// fail in practice, such as parsing a constant:
+//
+//	n := Must(strconv.Atoi("42"))
+//
+// Only use it where an error means a bug. For input that can really be
+// wrong, check err as usual.
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(err) // Panic with the error itself so recover can inspect it
+	}
+	return v
+}
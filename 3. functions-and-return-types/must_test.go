@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMustReturnsValue(t *testing.T) {
+	if got := Must(strconv.Atoi("42")); got != 42 {
+		t.Errorf("Must(strconv.Atoi(\"42\")) = %d; expected 42", got)
+	}
+	if got := Must("ok", nil); got != "ok" {
+		t.Errorf("Must(\"ok\", nil) = %q; expected \"ok\"", got)
+	}
+}
+
+func TestMustPanicsOnError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected Must to panic")
+		}
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, errBoom) {
+			t.Errorf("panic value = %v; expected the error passed to Must", r)
+		}
+	}()
+
+	Must(0, errBoom)
+}
+
+func TestMustWithSafeCall(t *testing.T) {
+	err := SafeCall(func() {
+		Must(strconv.Atoi("not a number"))
+	})
+
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("SafeCall returned %v; expected it to wrap strconv.ErrSyntax", err)
+	}
+}
@@ -0,0 +1,18 @@
+package main
+
+import "time"
+
+// RetryIf calls fn up to attempts times, waiting delay between tries.
+// It only retries when isRetryable says the error is temporary; any other
+// error is returned straight away. fn is always called at least once.
+// If every attempt fails, the last error is returned.
+func RetryIf(attempts int, delay time.Duration, isRetryable func(error) bool, fn func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil || !isRetryable(err) || attempt >= attempts {
+			return err
+		}
+		time.Sleep(delay)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// temporaryError is treated as retryable by the classifier below
+type temporaryError struct {
+	msg string
+}
+
+func (e temporaryError) Error() string {
+	return e.msg
+}
+
+var errFatal = errors.New("fatal error")
+
+func isTemporary(err error) bool {
+	var tempErr temporaryError
+	return errors.As(err, &tempErr)
+}
+
+func TestRetryIfRetriesTemporaryErrors(t *testing.T) {
+	calls := 0
+	err := RetryIf(5, 0, isTemporary, func() error {
+		calls++
+		if calls < 3 {
+			return temporaryError{"connection reset"}
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("RetryIf returned %v; expected success on the 3rd attempt", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times; expected 3", calls)
+	}
+}
+
+func TestRetryIfStopsOnFatalError(t *testing.T) {
+	calls := 0
+	err := RetryIf(5, 0, isTemporary, func() error {
+		calls++
+		return errFatal
+	})
+
+	if !errors.Is(err, errFatal) {
+		t.Errorf("RetryIf returned %v; expected errFatal", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times; expected 1 (fatal errors are not retried)", calls)
+	}
+}
+
+func TestRetryIfGivesUpAfterAttempts(t *testing.T) {
+	calls := 0
+	err := RetryIf(3, 0, isTemporary, func() error {
+		calls++
+		return temporaryError{"timeout"}
+	})
+
+	if !isTemporary(err) {
+		t.Errorf("RetryIf returned %v; expected the last temporary error", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times; expected 3", calls)
+	}
+}
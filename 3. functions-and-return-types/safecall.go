@@ -0,0 +1,38 @@
+package main
+
+import "fmt"
+
+// SafeCall runs f and turns a panic into an ordinary error
+// recover() only works inside a deferred function, so the deferred closure
+// catches the panic and assigns it to the named return value err
+func SafeCall(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicToError(r)
+		}
+	}()
+
+	f()
+	return nil
+}
+
+// SafeCallR is like SafeCall but for functions that also return a value
+// If f panics, the zero value of T is returned along with the error
+func SafeCallR[T any](f func() T) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicToError(r)
+		}
+	}()
+
+	return f(), nil
+}
+
+// panicToError keeps the original message of the panic value
+// Errors are wrapped with %w so callers can still use errors.Is / errors.As
+func panicToError(r any) error {
+	if e, ok := r.(error); ok {
+		return fmt.Errorf("recovered from panic: %w", e)
+	}
+	return fmt.Errorf("recovered from panic: %v", r)
+}
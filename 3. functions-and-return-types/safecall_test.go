@@ -0,0 +1,65 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSafeCallNoPanic(t *testing.T) {
+	called := false
+	err := SafeCall(func() {
+		called = true
+	})
+
+	if err != nil {
+		t.Errorf("SafeCall returned %v; expected nil", err)
+	}
+	if !called {
+		t.Error("SafeCall did not run the function")
+	}
+}
+
+func TestSafeCallPanicWithString(t *testing.T) {
+	err := SafeCall(func() {
+		panic("something went wrong")
+	})
+
+	if err == nil {
+		t.Fatal("expected an error from a panicking function")
+	}
+	if !strings.Contains(err.Error(), "something went wrong") {
+		t.Errorf("error %q does not contain the panic message", err)
+	}
+}
+
+func TestSafeCallPanicWithError(t *testing.T) {
+	errBoom := errors.New("boom")
+	err := SafeCall(func() {
+		panic(errBoom)
+	})
+
+	if !errors.Is(err, errBoom) {
+		t.Errorf("SafeCall error = %v; expected it to wrap errBoom", err)
+	}
+}
+
+func TestSafeCallR(t *testing.T) {
+	result, err := SafeCallR(func() int {
+		return factorial(5)
+	})
+	if err != nil || result != 120 {
+		t.Errorf("SafeCallR = (%d, %v); expected (120, nil)", result, err)
+	}
+
+	result, err = SafeCallR(func() int {
+		var numbers []int
+		return numbers[3] // index out of range panics
+	})
+	if err == nil {
+		t.Error("expected an error from an out-of-range index")
+	}
+	if result != 0 {
+		t.Errorf("result = %d; expected zero value 0", result)
+	}
+}
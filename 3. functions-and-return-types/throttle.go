@@ -0,0 +1,39 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// Throttle returns a wrapper that calls fn at most once per interval.
+// The first call runs fn straight away; calls that arrive before interval
+// has passed since the last run are dropped, not delayed. This is useful
+// for events that fire very often, like scroll or resize, when handling
+// some of them is enough.
+//
+// The wrapper is safe to call from multiple goroutines. fn runs on the
+// calling goroutine.
+func Throttle(fn func(), interval time.Duration) func() {
+	return throttle(fn, interval, time.Now)
+}
+
+// throttle is Throttle with the clock passed in, so tests can control time
+func throttle(fn func(), interval time.Duration, now func() time.Time) func() {
+	var mu sync.Mutex
+	var lastRun time.Time
+	ran := false
+
+	return func() {
+		mu.Lock()
+		current := now()
+		if ran && current.Sub(lastRun) < interval {
+			mu.Unlock()
+			return // Too soon - drop this call
+		}
+		ran = true
+		lastRun = current
+		mu.Unlock()
+
+		fn()
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestThrottleFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := start
+	calls := 0
+	throttled := throttle(func() { calls++ }, 100*time.Millisecond, func() time.Time { return current })
+
+	// Call every 10ms for one second: fn should run at 0, 100, 200 ... 900ms
+	for ms := 0; ms < 1000; ms += 10 {
+		current = start.Add(time.Duration(ms) * time.Millisecond)
+		throttled()
+	}
+
+	if calls != 10 {
+		t.Errorf("fn ran %d times; expected 10 (once per 100ms over 1s)", calls)
+	}
+}
+
+func TestThrottleFirstCallRunsImmediately(t *testing.T) {
+	calls := 0
+	throttled := Throttle(func() { calls++ }, time.Hour)
+
+	for range 100 {
+		throttled()
+	}
+
+	if calls != 1 {
+		t.Errorf("fn ran %d times; expected 1 (the rest are within the interval)", calls)
+	}
+}
+
+func TestThrottleRunsAgainAfterInterval(t *testing.T) {
+	calls := 0
+	throttled := Throttle(func() { calls++ }, 20*time.Millisecond)
+
+	throttled()
+	throttled() // Dropped
+	time.Sleep(30 * time.Millisecond)
+	throttled()
+
+	if calls != 2 {
+		t.Errorf("fn ran %d times; expected 2", calls)
+	}
+}
+
+func TestThrottleConcurrentCalls(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	throttled := Throttle(func() {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+	}, time.Hour)
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			throttled()
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("fn ran %d times; expected 1", calls)
+	}
+}
@@ -0,0 +1,3 @@
+module arrays-slices-loops
+
+go 1.23.0
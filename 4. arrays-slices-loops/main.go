@@ -1,9 +1,14 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+
+	"arrays-slices-loops/sliceutil"
+)
 
 func main() {
-	fmt.Println("=== Arrays, Slices, and Loops in Go ===\n")
+	fmt.Println("=== Arrays, Slices, and Loops in Go ===")
+	fmt.Println()
 
 	// 1. ARRAYS - Fixed Length
 	fmt.Println("1. ARRAYS (Fixed Length):")
@@ -216,5 +221,70 @@ func main() {
 	fmt.Printf("All numbers: %v\n", allNumbers)
 	fmt.Printf("Even numbers: %v\n", evenNumbers)
 
+	// 18. GENERIC HELPERS - Map, Filter, Reduce
+	fmt.Println("\n18. GENERIC HELPERS (sliceutil package):")
+	evens := sliceutil.Filter(allNumbers, func(n int) bool { return n%2 == 0 })
+	squares := sliceutil.Map(evens, func(n int) int { return n * n })
+	total := sliceutil.Reduce(squares, 0, func(acc, n int) int { return acc + n })
+	fmt.Printf("Filter (even): %v\n", evens)
+	fmt.Printf("Map (square): %v\n", squares)
+	fmt.Printf("Reduce (sum): %d\n", total)
+
+	// Partition does the even/odd split from section 17 in one pass
+	evenPart, oddPart := sliceutil.Partition(allNumbers, func(n int) bool { return n%2 == 0 })
+	fmt.Printf("Partition (even / odd): %v / %v\n", evenPart, oddPart)
+
+	reversed := sliceutil.Reverse(languages)
+	fmt.Printf("Reverse: %v (original still %v)\n", reversed, languages)
+	sliceutil.ReverseInPlace(languages)
+	fmt.Printf("ReverseInPlace: %v\n", languages)
+
+	pages := sliceutil.Chunk(allNumbers, 3)
+	for i, page := range pages {
+		fmt.Printf("Page %d: %v\n", i+1, page)
+	}
+
+	for _, window := range sliceutil.Windows(grades, 3) {
+		windowSum := sliceutil.Reduce(window, 0.0, func(acc, g float64) float64 { return acc + g })
+		fmt.Printf("Window %v: moving average %.2f\n", window, windowSum/3)
+	}
+
+	fmt.Printf("Unique: %v\n", sliceutil.Unique([]int{1, 2, 2, 3, 1}))
+	fmt.Printf("Flatten matrix: %v\n", sliceutil.Flatten(matrix))
+
+	// Only 3 students, so the last 2 grades are dropped
+	students := []string{"Alice", "Bob", "Charlie"}
+	for _, pair := range sliceutil.Zip(students, grades) {
+		fmt.Printf("Zip: %s scored %.1f\n", pair.First, pair.Second)
+	}
+
+	if index, found := sliceutil.BinarySearch(evens, 8); found {
+		fmt.Printf("BinarySearch: 8 is at index %d of %v\n", index, evens)
+	}
+
+	byRemainder := sliceutil.GroupBy(allNumbers, func(n int) int { return n % 3 })
+	fmt.Printf("GroupBy (remainder after dividing by 3): %v\n", byRemainder)
+
+	// 19. MATRIX TYPE - Add, Multiply, Transpose
+	fmt.Println("\n19. MATRIX TYPE:")
+	a, _ := NewMatrixFromRows([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+	fmt.Printf("A (%dx%d):\n%v\n", a.Rows(), a.Cols(), a)
+	fmt.Printf("A transposed:\n%v\n", a.Transpose())
+
+	product, err := a.Multiply(a.Transpose())
+	if err != nil {
+		fmt.Println("Error:", err)
+	} else {
+		fmt.Printf("A x A transposed:\n%v\n", product)
+	}
+
+	// A is 2x3, so it can't be multiplied by itself
+	if _, err := a.Multiply(a); err != nil {
+		fmt.Println("A x A:", err)
+	}
+
 	fmt.Println("\n=== Program Complete ===")
 }
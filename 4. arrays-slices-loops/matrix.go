@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDimensionMismatch is returned when two matrices don't have the right
+// shapes for an operation, or when rows passed to NewMatrixFromRows have
+// different lengths
+var ErrDimensionMismatch = errors.New("matrix dimensions do not match")
+
+// Matrix is a rows x cols grid of numbers stored as a 2D slice.
+// Create one with NewMatrix or NewMatrixFromRows.
+type Matrix struct {
+	rows, cols int
+	data       [][]float64
+}
+
+// NewMatrix returns a rows x cols matrix filled with zeros
+func NewMatrix(rows, cols int) *Matrix {
+	data := make([][]float64, rows)
+	for i := range data {
+		data[i] = make([]float64, cols)
+	}
+	return &Matrix{rows: rows, cols: cols, data: data}
+}
+
+// NewMatrixFromRows builds a matrix from a 2D slice, one inner slice per row.
+// The values are copied, so changing values afterwards doesn't change the
+// matrix. Every row must have the same length.
+func NewMatrixFromRows(values [][]float64) (*Matrix, error) {
+	cols := 0
+	if len(values) > 0 {
+		cols = len(values[0])
+	}
+
+	m := NewMatrix(len(values), cols)
+	for i, row := range values {
+		if len(row) != cols {
+			return nil, fmt.Errorf("%w: row %d has %d values, expected %d", ErrDimensionMismatch, i, len(row), cols)
+		}
+		copy(m.data[i], row)
+	}
+	return m, nil
+}
+
+// Rows returns the number of rows
+func (m *Matrix) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns
+func (m *Matrix) Cols() int {
+	return m.cols
+}
+
+// At returns the value at row i, column j (both start at 0)
+func (m *Matrix) At(i, j int) float64 {
+	return m.data[i][j]
+}
+
+// Add returns a new matrix where each value is the sum of the values at the
+// same position in m and other. Both must have the same shape.
+func (m *Matrix) Add(other *Matrix) (*Matrix, error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, fmt.Errorf("%w: cannot add %dx%d and %dx%d", ErrDimensionMismatch, m.rows, m.cols, other.rows, other.cols)
+	}
+
+	result := NewMatrix(m.rows, m.cols)
+	for i := range m.rows {
+		for j := range m.cols {
+			result.data[i][j] = m.data[i][j] + other.data[i][j]
+		}
+	}
+	return result, nil
+}
+
+// Multiply returns the matrix product m x other. The number of columns in m
+// must equal the number of rows in other; the result has m's rows and
+// other's columns. Each value is row i of m times column j of other, summed.
+func (m *Matrix) Multiply(other *Matrix) (*Matrix, error) {
+	if m.cols != other.rows {
+		return nil, fmt.Errorf("%w: cannot multiply %dx%d by %dx%d", ErrDimensionMismatch, m.rows, m.cols, other.rows, other.cols)
+	}
+
+	result := NewMatrix(m.rows, other.cols)
+	for i := range m.rows {
+		for j := range other.cols {
+			sum := 0.0
+			for k := range m.cols {
+				sum += m.data[i][k] * other.data[k][j]
+			}
+			result.data[i][j] = sum
+		}
+	}
+	return result, nil
+}
+
+// Transpose returns a new matrix with rows and columns swapped:
+// the value at (i, j) moves to (j, i)
+func (m *Matrix) Transpose() *Matrix {
+	result := NewMatrix(m.cols, m.rows)
+	for i := range m.rows {
+		for j := range m.cols {
+			result.data[j][i] = m.data[i][j]
+		}
+	}
+	return result
+}
+
+// String prints one row per line, so fmt.Println(m) shows the grid
+func (m *Matrix) String() string {
+	var sb strings.Builder
+	for i, row := range m.data {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprint(&sb, row)
+	}
+	return sb.String()
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// mustMatrix builds a matrix from rows, failing the test if they are ragged
+func mustMatrix(t *testing.T, values [][]float64) *Matrix {
+	t.Helper()
+	m, err := NewMatrixFromRows(values)
+	if err != nil {
+		t.Fatalf("NewMatrixFromRows(%v) error = %v", values, err)
+	}
+	return m
+}
+
+func TestMatrixMultiply(t *testing.T) {
+	a := mustMatrix(t, [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+	b := mustMatrix(t, [][]float64{
+		{7, 8},
+		{9, 10},
+		{11, 12},
+	})
+
+	result, err := a.Multiply(b)
+	if err != nil {
+		t.Fatalf("Multiply error = %v", err)
+	}
+
+	// e.g. top left: 1*7 + 2*9 + 3*11 = 58
+	expected := [][]float64{
+		{58, 64},
+		{139, 154},
+	}
+	if !reflect.DeepEqual(result.data, expected) {
+		t.Errorf("Multiply = %v; expected %v", result.data, expected)
+	}
+	if result.Rows() != 2 || result.Cols() != 2 {
+		t.Errorf("Multiply shape = %dx%d; expected 2x2", result.Rows(), result.Cols())
+	}
+}
+
+func TestMatrixMultiplyByIdentity(t *testing.T) {
+	m := mustMatrix(t, [][]float64{{1, 2}, {3, 4}})
+	identity := mustMatrix(t, [][]float64{{1, 0}, {0, 1}})
+
+	result, err := m.Multiply(identity)
+	if err != nil || !reflect.DeepEqual(result.data, m.data) {
+		t.Errorf("Multiply(identity) = %v, %v; expected %v", result, err, m.data)
+	}
+}
+
+func TestMatrixAdd(t *testing.T) {
+	a := mustMatrix(t, [][]float64{{1, 2}, {3, 4}})
+	b := mustMatrix(t, [][]float64{{10, 20}, {30, 40}})
+
+	result, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("Add error = %v", err)
+	}
+
+	expected := [][]float64{{11, 22}, {33, 44}}
+	if !reflect.DeepEqual(result.data, expected) {
+		t.Errorf("Add = %v; expected %v", result.data, expected)
+	}
+}
+
+func TestMatrixTranspose(t *testing.T) {
+	m := mustMatrix(t, [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	result := m.Transpose()
+
+	expected := [][]float64{
+		{1, 4},
+		{2, 5},
+		{3, 6},
+	}
+	if !reflect.DeepEqual(result.data, expected) {
+		t.Errorf("Transpose = %v; expected %v", result.data, expected)
+	}
+	if result.Rows() != 3 || result.Cols() != 2 {
+		t.Errorf("Transpose shape = %dx%d; expected 3x2", result.Rows(), result.Cols())
+	}
+}
+
+func TestMatrixDimensionMismatch(t *testing.T) {
+	twoByThree := NewMatrix(2, 3)
+	twoByTwo := NewMatrix(2, 2)
+
+	if _, err := twoByThree.Add(twoByTwo); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Add(2x3, 2x2) error = %v; expected ErrDimensionMismatch", err)
+	}
+	// 2x3 times 2x2: 3 columns but only 2 rows
+	if _, err := twoByThree.Multiply(twoByTwo); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Multiply(2x3, 2x2) error = %v; expected ErrDimensionMismatch", err)
+	}
+	// 2x2 times 2x3 is fine and gives 2x3
+	if result, err := twoByTwo.Multiply(twoByThree); err != nil || result.Rows() != 2 || result.Cols() != 3 {
+		t.Errorf("Multiply(2x2, 2x3) = %v, %v; expected a 2x3 matrix", result, err)
+	}
+}
+
+func TestNewMatrixFromRows(t *testing.T) {
+	values := [][]float64{{1, 2}, {3, 4}}
+	m := mustMatrix(t, values)
+
+	values[0][0] = 99 // The matrix has its own copy
+	if m.At(0, 0) != 1 {
+		t.Errorf("At(0, 0) = %v after changing the input; expected 1", m.At(0, 0))
+	}
+
+	if _, err := NewMatrixFromRows([][]float64{{1, 2}, {3}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("NewMatrixFromRows(ragged) error = %v; expected ErrDimensionMismatch", err)
+	}
+}
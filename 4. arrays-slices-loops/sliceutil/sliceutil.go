@@ -0,0 +1,232 @@
+// Package sliceutil contains small generic helpers for working with slices.
+// They replace the hand-written loops from main.go with reusable functions
+// that work for any element type.
+package sliceutil
+
+import "cmp"
+
+// Map returns a new slice with f applied to every element of s
+func Map[T, U any](s []T, f func(T) U) []U {
+	result := make([]U, 0, len(s))
+	for _, item := range s {
+		result = append(result, f(item))
+	}
+	return result
+}
+
+// Filter returns a new slice with only the elements where pred returns true
+func Filter[T any](s []T, pred func(T) bool) []T {
+	var result []T
+	for _, item := range s {
+		if pred(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Reduce combines all elements into a single value, starting from init
+// e.g. Reduce(nums, 0, func(sum, n int) int { return sum + n })
+func Reduce[T, U any](s []T, init U, f func(U, T) U) U {
+	acc := init
+	for _, item := range s {
+		acc = f(acc, item)
+	}
+	return acc
+}
+
+// Reverse returns a new slice with the elements of s in reverse order
+// The original slice is left untouched
+func Reverse[T any](s []T) []T {
+	result := make([]T, len(s))
+	for i, item := range s {
+		result[len(s)-1-i] = item
+	}
+	return result
+}
+
+// ReverseInPlace reverses s by swapping elements from both ends
+// towards the middle. It modifies the slice it is given.
+func ReverseInPlace[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Chunk splits s into batches of length size; the last batch holds whatever
+// is left over. It returns nil when size <= 0 or s is empty.
+// The batches share memory with s (no copying), but each one is capped
+// so appending to a batch can't overwrite the next one.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 || len(s) == 0 {
+		return nil
+	}
+
+	chunks := make([][]T, 0, (len(s)+size-1)/size)
+	for start := 0; start < len(s); start += size {
+		end := min(start+size, len(s))
+		chunks = append(chunks, s[start:end:end])
+	}
+	return chunks
+}
+
+// Windows returns every run of size neighbouring elements, sliding one step
+// at a time: Windows([1 2 3 4], 2) is [[1 2] [2 3] [3 4]]. It returns nil
+// when size <= 0 or size > len(s).
+// Like Chunk, the windows share memory with s and are capped, so appending
+// to one window can't overwrite the element after it.
+func Windows[T any](s []T, size int) [][]T {
+	if size <= 0 || size > len(s) {
+		return nil
+	}
+
+	windows := make([][]T, 0, len(s)-size+1)
+	for start := 0; start+size <= len(s); start++ {
+		end := start + size
+		windows = append(windows, s[start:end:end])
+	}
+	return windows
+}
+
+// Unique returns the distinct elements of s, keeping the order in which
+// each one was first seen. A map is used as a set of values already added.
+func Unique[T comparable](s []T) []T {
+	seen := make(map[T]bool)
+	var result []T
+	for _, item := range s {
+		if !seen[item] {
+			seen[item] = true
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// UniqueBy is like Unique but compares elements by a derived key, which is
+// handy for structs (e.g. dedupe people by email). The first element with
+// each key is kept.
+func UniqueBy[T any, K comparable](s []T, key func(T) K) []T {
+	seen := make(map[K]bool)
+	var result []T
+	for _, item := range s {
+		k := key(item)
+		if !seen[k] {
+			seen[k] = true
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// SliceEqualUnordered reports whether a and b hold the same elements the
+// same number of times, in any order (i.e. they are equal as multisets).
+// Useful in tests where results arrive from goroutines in no fixed order.
+func SliceEqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[T]int)
+	for _, item := range a {
+		counts[item]++
+	}
+	for _, item := range b {
+		counts[item]--
+		if counts[item] < 0 {
+			return false // b has more of this item than a
+		}
+	}
+	return true
+}
+
+// Flatten joins the rows of a 2D slice into one slice, row by row
+// (row-major order). Empty rows simply contribute nothing.
+func Flatten[T any](s [][]T) []T {
+	total := 0
+	for _, row := range s {
+		total += len(row)
+	}
+
+	result := make([]T, 0, total)
+	for _, row := range s {
+		result = append(result, row...)
+	}
+	return result
+}
+
+// BinarySearch looks for target in s, which must be sorted in ascending
+// order. It returns the index of target and true if it is there; otherwise
+// it returns the index where target would be inserted to keep s sorted, and
+// false. If target appears more than once, the first index is returned.
+//
+// Each step halves the range still to search, so it takes about log2(n)
+// comparisons instead of n. On an unsorted slice the result is meaningless:
+// it may miss elements that are there.
+func BinarySearch[T cmp.Ordered](s []T, target T) (int, bool) {
+	low, high := 0, len(s) // target, if present, is somewhere in s[low:high]
+	for low < high {
+		mid := low + (high-low)/2 // Same as (low+high)/2 but can't overflow
+		if s[mid] < target {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low, low < len(s) && s[low] == target
+}
+
+// GroupBy sorts the elements of s into groups by key, e.g. students by
+// grade. Each group keeps the elements in the order they appear in s.
+// Map iteration order is random, so range over the keys in a fixed order
+// if you print the groups.
+func GroupBy[T any, K comparable](s []T, keyFn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, item := range s {
+		k := keyFn(item)
+		groups[k] = append(groups[k], item)
+	}
+	return groups
+}
+
+// Partition splits s in two: matched holds the elements where pred returns
+// true and rest holds the others. Both keep the order from s.
+// It's like calling Filter twice, but only runs pred once per element.
+func Partition[T any](s []T, pred func(T) bool) (matched, rest []T) {
+	for _, item := range s {
+		if pred(item) {
+			matched = append(matched, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	return matched, rest
+}
+
+// Pair holds two values that belong together, possibly of different types
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs up the elements of as and bs by index: the first with the
+// first, the second with the second and so on. If one slice is longer,
+// its extra elements are dropped.
+func Zip[A, B any](as []A, bs []B) []Pair[A, B] {
+	n := min(len(as), len(bs))
+	pairs := make([]Pair[A, B], n)
+	for i := range n {
+		pairs[i] = Pair[A, B]{as[i], bs[i]}
+	}
+	return pairs
+}
+
+// Unzip is the reverse of Zip: it splits pairs back into a slice of first
+// values and a slice of second values
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
@@ -0,0 +1,426 @@
+package sliceutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	allNumbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	evens := Filter(allNumbers, func(n int) bool { return n%2 == 0 })
+
+	expected := []int{2, 4, 6, 8, 10}
+	if !reflect.DeepEqual(evens, expected) {
+		t.Errorf("Filter(evens) = %v; expected %v", evens, expected)
+	}
+}
+
+func TestMap(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+
+	squares := Map(numbers, func(n int) int { return n * n })
+
+	expected := []int{1, 4, 9, 16, 25}
+	if !reflect.DeepEqual(squares, expected) {
+		t.Errorf("Map(square) = %v; expected %v", squares, expected)
+	}
+}
+
+func TestMapChangesType(t *testing.T) {
+	lengths := Map([]string{"Go", "Python", "Rust"}, func(s string) int { return len(s) })
+
+	expected := []int{2, 6, 4}
+	if !reflect.DeepEqual(lengths, expected) {
+		t.Errorf("Map(len) = %v; expected %v", lengths, expected)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	grades := []float64{85.5, 92.0, 78.5, 90.0, 88.5}
+
+	sum := Reduce(grades, 0.0, func(acc, g float64) float64 { return acc + g })
+
+	if sum != 434.5 {
+		t.Errorf("Reduce(sum) = %.2f; expected 434.50", sum)
+	}
+}
+
+func TestEmptyInput(t *testing.T) {
+	if got := Filter([]int{}, func(int) bool { return true }); len(got) != 0 {
+		t.Errorf("Filter(empty) = %v; expected empty", got)
+	}
+	if got := Map([]int{}, func(n int) int { return n }); len(got) != 0 {
+		t.Errorf("Map(empty) = %v; expected empty", got)
+	}
+	if got := Reduce([]int{}, 42, func(acc, n int) int { return acc + n }); got != 42 {
+		t.Errorf("Reduce(empty, 42) = %d; expected 42", got)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"odd length", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+		{"even length", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+		{"single element", []int{7}, []int{7}},
+		{"empty", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]int{}, tt.input...)
+
+			got := Reverse(tt.input)
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Reverse(%v) = %v; expected %v", tt.input, got, tt.expected)
+			}
+			if !reflect.DeepEqual(tt.input, original) {
+				t.Errorf("Reverse modified its input: %v; expected %v", tt.input, original)
+			}
+		})
+	}
+}
+
+func TestReverseInPlace(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"odd length", []string{"a", "b", "c"}, []string{"c", "b", "a"}},
+		{"even length", []string{"a", "b", "c", "d"}, []string{"d", "c", "b", "a"}},
+		{"empty", []string{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ReverseInPlace(tt.input)
+
+			if !reflect.DeepEqual(tt.input, tt.expected) {
+				t.Errorf("after ReverseInPlace: %v; expected %v", tt.input, tt.expected)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	chunks := Chunk(numbers, 3)
+
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {10}}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("Chunk(1..10, 3) = %v; expected %v", chunks, expected)
+	}
+}
+
+func TestChunkEdgeCases(t *testing.T) {
+	if got := Chunk([]int{}, 3); got != nil {
+		t.Errorf("Chunk(empty, 3) = %v; expected nil", got)
+	}
+	if got := Chunk([]int{1, 2, 3}, 0); got != nil {
+		t.Errorf("Chunk(s, 0) = %v; expected nil", got)
+	}
+	if got := Chunk([]int{1, 2, 3}, -1); got != nil {
+		t.Errorf("Chunk(s, -1) = %v; expected nil", got)
+	}
+	if got := Chunk([]int{1, 2}, 5); !reflect.DeepEqual(got, [][]int{{1, 2}}) {
+		t.Errorf("Chunk([1 2], 5) = %v; expected [[1 2]]", got)
+	}
+}
+
+func TestChunkAppendDoesNotOverwrite(t *testing.T) {
+	numbers := []int{1, 2, 3, 4}
+	chunks := Chunk(numbers, 2)
+
+	chunks[0] = append(chunks[0], 99)
+
+	if !reflect.DeepEqual(chunks[1], []int{3, 4}) {
+		t.Errorf("appending to chunk 0 changed chunk 1 to %v", chunks[1])
+	}
+}
+
+func TestWindows(t *testing.T) {
+	got := Windows([]int{1, 2, 3, 4}, 2)
+
+	expected := [][]int{{1, 2}, {2, 3}, {3, 4}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Windows([1 2 3 4], 2) = %v; expected %v", got, expected)
+	}
+}
+
+func TestWindowsEdgeCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        []int
+		size     int
+		expected [][]int
+	}{
+		{"size equals length", []int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+		{"size one", []int{1, 2, 3}, 1, [][]int{{1}, {2}, {3}}},
+		{"size larger than slice", []int{1, 2}, 3, nil},
+		{"size zero", []int{1, 2}, 0, nil},
+		{"negative size", []int{1, 2}, -1, nil},
+		{"empty slice", []int{}, 1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Windows(tt.s, tt.size); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Windows(%v, %d) = %v; expected %v", tt.s, tt.size, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWindowsAppendDoesNotOverwrite(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	windows := Windows(s, 2)
+
+	_ = append(windows[0], 99)
+
+	if s[2] != 3 {
+		t.Errorf("appending to a window changed s to %v", s)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	got := Unique([]int{1, 2, 2, 3, 1})
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unique([1 2 2 3 1]) = %v; expected %v", got, expected)
+	}
+
+	if got := Unique([]string{}); len(got) != 0 {
+		t.Errorf("Unique(empty) = %v; expected empty", got)
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	type person struct {
+		name  string
+		email string
+	}
+	people := []person{
+		{"Alice", "alice@example.com"},
+		{"Bob", "bob@example.com"},
+		{"Alice Smith", "alice@example.com"}, // Same email as Alice
+		{"Charlie", "charlie@example.com"},
+	}
+
+	got := UniqueBy(people, func(p person) string { return p.email })
+
+	expected := []person{
+		{"Alice", "alice@example.com"},
+		{"Bob", "bob@example.com"},
+		{"Charlie", "charlie@example.com"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("UniqueBy(email) = %v; expected %v", got, expected)
+	}
+}
+
+func TestSliceEqualUnordered(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []int
+		expected bool
+	}{
+		{"same order", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different order", []int{1, 2, 3}, []int{3, 1, 2}, true},
+		{"different elements", []int{1, 2, 3}, []int{1, 2, 4}, false},
+		{"different lengths", []int{1, 2}, []int{1, 2, 2}, false},
+		{"same duplicates", []int{2, 1, 2}, []int{2, 2, 1}, true},
+		{"different duplicate counts", []int{1, 1, 2}, []int{1, 2, 2}, false},
+		{"both empty", []int{}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SliceEqualUnordered(tt.a, tt.b); got != tt.expected {
+				t.Errorf("SliceEqualUnordered(%v, %v) = %v; expected %v", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	matrix := [][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+
+	got := Flatten(matrix)
+
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Flatten(3x3) = %v; expected %v", got, expected)
+	}
+}
+
+func TestFlattenEmptyRows(t *testing.T) {
+	got := Flatten([][]string{{"a"}, {}, nil, {"b", "c"}})
+
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Flatten with empty rows = %v; expected %v", got, expected)
+	}
+
+	if got := Flatten[int](nil); len(got) != 0 {
+		t.Errorf("Flatten(nil) = %v; expected empty", got)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	sorted := []int{2, 4, 6, 8, 10}
+
+	tests := []struct {
+		name          string
+		s             []int
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}{
+		{"present in the middle", sorted, 6, 2, true},
+		{"first element", sorted, 2, 0, true},
+		{"last element", sorted, 10, 4, true},
+		{"absent between elements", sorted, 5, 2, false},
+		{"absent below all", sorted, 1, 0, false},
+		{"absent above all", sorted, 11, 5, false},
+		{"empty slice", []int{}, 3, 0, false},
+		{"nil slice", nil, 3, 0, false},
+		{"single element present", []int{7}, 7, 0, true},
+		{"duplicates return the first", []int{1, 3, 3, 3, 5}, 3, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := BinarySearch(tt.s, tt.target)
+			if index != tt.expectedIndex || found != tt.expectedFound {
+				t.Errorf("BinarySearch(%v, %d) = %d, %v; expected %d, %v",
+					tt.s, tt.target, index, found, tt.expectedIndex, tt.expectedFound)
+			}
+		})
+	}
+}
+
+func TestBinarySearchStrings(t *testing.T) {
+	names := []string{"Alice", "Bob", "Charlie", "Diana"}
+
+	if index, found := BinarySearch(names, "Charlie"); index != 2 || !found {
+		t.Errorf("BinarySearch(Charlie) = %d, %v; expected 2, true", index, found)
+	}
+	if index, found := BinarySearch(names, "Brian"); index != 2 || found {
+		t.Errorf("BinarySearch(Brian) = %d, %v; expected 2, false", index, found)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{
+		{"Alice", 30},
+		{"Bob", 25},
+		{"Anna", 22},
+		{"Charlie", 35},
+		{"Ben", 40},
+		{"Aaron", 28},
+	}
+
+	got := GroupBy(people, func(p person) byte { return p.name[0] })
+
+	expected := map[byte][]person{
+		'A': {{"Alice", 30}, {"Anna", 22}, {"Aaron", 28}}, // Same order as people
+		'B': {{"Bob", 25}, {"Ben", 40}},
+		'C': {{"Charlie", 35}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GroupBy(first letter) = %v; expected %v", got, expected)
+	}
+
+	if got := GroupBy([]person{}, func(p person) byte { return p.name[0] }); len(got) != 0 {
+		t.Errorf("GroupBy(empty) = %v; expected empty map", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	tests := []struct {
+		name            string
+		s               []int
+		expectedMatched []int
+		expectedRest    []int
+	}{
+		{"mixed", []int{1, 2, 3, 4, 5, 6}, []int{2, 4, 6}, []int{1, 3, 5}},
+		{"all match", []int{2, 4, 6}, []int{2, 4, 6}, nil},
+		{"none match", []int{1, 3, 5}, nil, []int{1, 3, 5}},
+		{"keeps order", []int{8, 3, 2, 7, 4}, []int{8, 2, 4}, []int{3, 7}},
+		{"empty", []int{}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, rest := Partition(tt.s, isEven)
+			if !reflect.DeepEqual(matched, tt.expectedMatched) || !reflect.DeepEqual(rest, tt.expectedRest) {
+				t.Errorf("Partition(%v, isEven) = %v, %v; expected %v, %v",
+					tt.s, matched, rest, tt.expectedMatched, tt.expectedRest)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	names := []string{"Alice", "Bob", "Charlie"}
+	ages := []int{30, 25, 35}
+
+	got := Zip(names, ages)
+
+	expected := []Pair[string, int]{{"Alice", 30}, {"Bob", 25}, {"Charlie", 35}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Zip(%v, %v) = %v; expected %v", names, ages, got, expected)
+	}
+}
+
+func TestZipUnequalLengths(t *testing.T) {
+	tests := []struct {
+		name     string
+		as       []string
+		bs       []int
+		expected []Pair[string, int]
+	}{
+		{"first longer", []string{"a", "b", "c"}, []int{1, 2}, []Pair[string, int]{{"a", 1}, {"b", 2}}},
+		{"second longer", []string{"a"}, []int{1, 2, 3}, []Pair[string, int]{{"a", 1}}},
+		{"one empty", nil, []int{1, 2}, []Pair[string, int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Zip(tt.as, tt.bs); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Zip(%v, %v) = %v; expected %v", tt.as, tt.bs, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestZipUnzipRoundTrip(t *testing.T) {
+	letters := []string{"x", "y", "z"}
+	scores := []float64{1.5, 2.5, 3.5}
+
+	gotLetters, gotScores := Unzip(Zip(letters, scores))
+
+	if !reflect.DeepEqual(gotLetters, letters) || !reflect.DeepEqual(gotScores, scores) {
+		t.Errorf("Unzip(Zip(%v, %v)) = %v, %v; expected the inputs back",
+			letters, scores, gotLetters, gotScores)
+	}
+}
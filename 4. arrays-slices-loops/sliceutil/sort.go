@@ -0,0 +1,75 @@
+package sliceutil
+
+import "cmp"
+
+// The sorts below are written out for learning. In real code use
+// slices.Sort, which is faster than all of them.
+
+// BubbleSort sorts s in place by repeatedly swapping neighbours that are in
+// the wrong order. After each pass the largest remaining element has
+// "bubbled" to the end, so the next pass can stop one earlier. If a pass
+// makes no swaps the slice is sorted and it stops early.
+// Takes O(n²) comparisons.
+func BubbleSort[T cmp.Ordered](s []T) {
+	for end := len(s) - 1; end > 0; end-- {
+		swapped := false
+		for i := 0; i < end; i++ {
+			if s[i] > s[i+1] {
+				s[i], s[i+1] = s[i+1], s[i]
+				swapped = true
+			}
+		}
+		if !swapped {
+			return
+		}
+	}
+}
+
+// InsertionSort sorts s in place the way you'd sort playing cards in your
+// hand: take each element in turn and shift it left past every larger
+// element before it. Takes O(n²) comparisons, but is fast when s is small
+// or almost sorted already.
+func InsertionSort[T cmp.Ordered](s []T) {
+	for i := 1; i < len(s); i++ {
+		current := s[i]
+		j := i - 1
+		for j >= 0 && s[j] > current {
+			s[j+1] = s[j] // Shift the larger element one place right
+			j--
+		}
+		s[j+1] = current
+	}
+}
+
+// QuickSort sorts s in place by picking a pivot, moving smaller elements
+// before it and the rest after it, then sorting both sides the same way.
+// Takes O(n log n) comparisons on average.
+func QuickSort[T cmp.Ordered](s []T) {
+	if len(s) < 2 {
+		return
+	}
+	p := partition(s)
+	QuickSort(s[:p])
+	QuickSort(s[p+1:])
+}
+
+// partition puts the middle element of s (the pivot) in its final sorted
+// position and returns that index. Everything before it is smaller and
+// everything after it is greater or equal. Using the middle element rather
+// than the last avoids the O(n²) worst case on already sorted input.
+func partition[T cmp.Ordered](s []T) int {
+	last := len(s) - 1
+	mid := len(s) / 2
+	s[mid], s[last] = s[last], s[mid] // Park the pivot at the end
+	pivot := s[last]
+
+	store := 0 // Next position for an element smaller than the pivot
+	for i := range last {
+		if s[i] < pivot {
+			s[i], s[store] = s[store], s[i]
+			store++
+		}
+	}
+	s[store], s[last] = s[last], s[store] // Move the pivot into place
+	return store
+}
@@ -0,0 +1,107 @@
+package sliceutil
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type sorter struct {
+	name string
+	sort func([]int)
+}
+
+// sorters lists every algorithm in sort.go so tests and benchmarks can
+// loop over them
+var sorters = []sorter{
+	{"BubbleSort", BubbleSort[int]},
+	{"InsertionSort", InsertionSort[int]},
+	{"QuickSort", QuickSort[int]},
+}
+
+// randomInts returns n numbers between 0 and max-1. A fixed seed makes
+// every run use the same "random" input, so failures can be reproduced.
+func randomInts(seed uint64, n, max int) []int {
+	r := rand.New(rand.NewPCG(seed, seed))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.IntN(max)
+	}
+	return s
+}
+
+func TestSortsMatchSlicesSort(t *testing.T) {
+	for seed := range uint64(50) {
+		// Sizes from 0 to 98; a small range of values gives plenty of duplicates
+		input := randomInts(seed, int(seed)*2, 20)
+		expected := slices.Clone(input)
+		slices.Sort(expected)
+
+		for _, sorter := range sorters {
+			got := slices.Clone(input)
+			sorter.sort(got)
+			if !slices.Equal(got, expected) {
+				t.Errorf("%s(%v) = %v; expected %v", sorter.name, input, got, expected)
+			}
+		}
+	}
+}
+
+func TestSortsEdgeCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"nil", nil, nil},
+		{"empty", []int{}, []int{}},
+		{"single element", []int{42}, []int{42}},
+		{"already sorted", []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{"reversed", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		{"all equal", []int{7, 7, 7, 7}, []int{7, 7, 7, 7}},
+		{"negative numbers", []int{3, -1, 0, -5, 2}, []int{-5, -1, 0, 2, 3}},
+	}
+
+	for _, sorter := range sorters {
+		for _, tt := range tests {
+			t.Run(sorter.name+"/"+tt.name, func(t *testing.T) {
+				got := slices.Clone(tt.input)
+				sorter.sort(got)
+				if !reflect.DeepEqual(got, tt.expected) {
+					t.Errorf("%s(%v) = %v; expected %v", sorter.name, tt.input, got, tt.expected)
+				}
+			})
+		}
+	}
+}
+
+func TestSortStrings(t *testing.T) {
+	names := []string{"Diana", "alice", "Bob", "Charlie"}
+
+	QuickSort(names)
+
+	// Uppercase letters sort before lowercase ones
+	expected := []string{"Bob", "Charlie", "Diana", "alice"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("QuickSort(names) = %v; expected %v", names, expected)
+	}
+}
+
+// Run with: go test ./sliceutil -bench=Sort -benchmem
+// BubbleSort and InsertionSort grow with n², so on 1000 elements they're
+// far slower than QuickSort and slices.Sort.
+func BenchmarkSort(b *testing.B) {
+	input := randomInts(1, 1000, 1000)
+	all := append(slices.Clone(sorters), sorter{"slices.Sort", slices.Sort[[]int]})
+
+	for _, sorter := range all {
+		b.Run(sorter.name, func(b *testing.B) {
+			s := make([]int, len(input))
+			for i := 0; i < b.N; i++ {
+				copy(s, input) // Each run needs unsorted input again
+				sorter.sort(s)
+			}
+		})
+	}
+}
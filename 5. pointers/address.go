@@ -0,0 +1,9 @@
+package main
+
+// SameAddress reports whether a and b point to the same variable.
+// Comparing pointers with == compares the addresses, not the values, so
+// two different variables holding equal values are not the same address.
+// Two nil pointers count as equal.
+func SameAddress[T any](a, b *T) bool {
+	return a == b
+}
@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestSameAddress(t *testing.T) {
+	x := 10
+	y := 10 // Same value, different variable
+	alias := &x
+
+	tests := []struct {
+		name     string
+		a, b     *int
+		expected bool
+	}{
+		{"same variable", &x, &x, true},
+		{"aliased pointer", alias, &x, true},
+		{"distinct variables with equal values", &x, &y, false},
+		{"one nil", &x, nil, false},
+		{"both nil", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameAddress(tt.a, tt.b); got != tt.expected {
+				t.Errorf("SameAddress = %v; expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSameAddressSliceElements(t *testing.T) {
+	nums := []int{1, 1}
+
+	if SameAddress(&nums[0], &nums[1]) {
+		t.Error("SameAddress(&nums[0], &nums[1]) = true; expected false")
+	}
+	if !SameAddress(&nums[0], &nums[:1][0]) {
+		t.Error("SameAddress of the same element through a reslice = false; expected true")
+	}
+}
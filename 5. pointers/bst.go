@@ -0,0 +1,78 @@
+package main
+
+import "cmp"
+
+// bstNode is one value in a BST. Left holds smaller values, Right larger.
+type bstNode[T cmp.Ordered] struct {
+	value T
+	left  *bstNode[T]
+	right *bstNode[T]
+}
+
+// BST is a binary search tree: every node's left subtree holds smaller
+// values and its right subtree larger ones, so a lookup only follows one
+// branch at each level. Each value is stored once - inserting a duplicate
+// does nothing. The zero value is an empty tree ready to use.
+type BST[T cmp.Ordered] struct {
+	root *bstNode[T]
+	size int
+}
+
+// Insert adds value to the tree. It reports false (and changes nothing)
+// if value is already there.
+func (t *BST[T]) Insert(value T) bool {
+	// link is the pointer that should point at the new node: start at the
+	// root and go left or right until we reach an empty spot
+	link := &t.root
+	for *link != nil {
+		switch {
+		case value < (*link).value:
+			link = &(*link).left
+		case value > (*link).value:
+			link = &(*link).right
+		default:
+			return false // Duplicate
+		}
+	}
+	*link = &bstNode[T]{value: value}
+	t.size++
+	return true
+}
+
+// Contains reports whether value is in the tree
+func (t *BST[T]) Contains(value T) bool {
+	node := t.root
+	for node != nil {
+		switch {
+		case value < node.value:
+			node = node.left
+		case value > node.value:
+			node = node.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of values in the tree
+func (t *BST[T]) Len() int {
+	return t.size
+}
+
+// InOrder returns the values in sorted order by visiting each node's left
+// subtree, then the node, then its right subtree
+func (t *BST[T]) InOrder() []T {
+	values := make([]T, 0, t.size)
+	var visit func(node *bstNode[T])
+	visit = func(node *bstNode[T]) {
+		if node == nil {
+			return
+		}
+		visit(node.left)
+		values = append(values, node.value)
+		visit(node.right)
+	}
+	visit(t.root)
+	return values
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBSTInOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		inserts  []int
+		expected []int
+	}{
+		{"empty", nil, []int{}},
+		{"single", []int{5}, []int{5}},
+		{"mixed", []int{5, 3, 8, 1, 4, 9, 7}, []int{1, 3, 4, 5, 7, 8, 9}},
+		{"already sorted", []int{1, 2, 3, 4}, []int{1, 2, 3, 4}},
+		{"reverse sorted", []int{4, 3, 2, 1}, []int{1, 2, 3, 4}},
+		{"negative", []int{0, -5, 5, -10}, []int{-10, -5, 0, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tree BST[int]
+			for _, v := range tt.inserts {
+				tree.Insert(v)
+			}
+
+			if got := tree.InOrder(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("InOrder() = %v; expected %v", got, tt.expected)
+			}
+			if tree.Len() != len(tt.expected) {
+				t.Errorf("Len() = %d; expected %d", tree.Len(), len(tt.expected))
+			}
+		})
+	}
+}
+
+func TestBSTDuplicatesIgnored(t *testing.T) {
+	var tree BST[int]
+
+	if !tree.Insert(3) {
+		t.Error("Insert(3) = false; expected true for a new value")
+	}
+	if tree.Insert(3) {
+		t.Error("Insert(3) again = true; expected false for a duplicate")
+	}
+	tree.Insert(1)
+	tree.Insert(1)
+
+	expected := []int{1, 3}
+	if got := tree.InOrder(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("InOrder() = %v; expected %v", got, expected)
+	}
+	if tree.Len() != 2 {
+		t.Errorf("Len() = %d; expected 2", tree.Len())
+	}
+}
+
+func TestBSTContains(t *testing.T) {
+	var tree BST[string]
+	for _, word := range []string{"mango", "apple", "peach", "banana"} {
+		tree.Insert(word)
+	}
+
+	for _, word := range []string{"mango", "apple", "peach", "banana"} {
+		if !tree.Contains(word) {
+			t.Errorf("Contains(%q) = false; expected true", word)
+		}
+	}
+	for _, word := range []string{"cherry", "", "zebra"} {
+		if tree.Contains(word) {
+			t.Errorf("Contains(%q) = true; expected false", word)
+		}
+	}
+
+	expected := []string{"apple", "banana", "mango", "peach"}
+	if got := tree.InOrder(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("InOrder() = %v; expected %v", got, expected)
+	}
+}
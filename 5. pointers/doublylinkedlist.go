@@ -0,0 +1,99 @@
+package main
+
+// DNode is one element of a DoublyLinkedList. Unlike Node it also points
+// back to the previous node, so the list can be walked in both directions.
+type DNode struct {
+	Value int
+	Prev  *DNode
+	Next  *DNode
+
+	list *DoublyLinkedList // The list this node belongs to, nil once deleted
+}
+
+// DoublyLinkedList is a linked list of ints where every node knows both
+// its neighbours. Keeping a tail pointer as well as the head makes adding
+// at either end cheap, and a node can be deleted without searching for
+// the node before it. The zero value is an empty list ready to use.
+type DoublyLinkedList struct {
+	head *DNode
+	tail *DNode
+	size int
+}
+
+// PushBack adds value at the end of the list and returns its node
+func (l *DoublyLinkedList) PushBack(value int) *DNode {
+	if l.tail == nil {
+		node := &DNode{Value: value, list: l}
+		l.head, l.tail = node, node
+		l.size++
+		return node
+	}
+	return l.InsertAfter(l.tail, value)
+}
+
+// InsertAfter adds value right after node and returns the new node.
+// It returns nil if node doesn't belong to this list.
+func (l *DoublyLinkedList) InsertAfter(node *DNode, value int) *DNode {
+	if node == nil || node.list != l {
+		return nil
+	}
+
+	// Four pointers change: the new node's two, plus one on each side
+	newNode := &DNode{Value: value, Prev: node, Next: node.Next, list: l}
+	if node.Next != nil {
+		node.Next.Prev = newNode
+	} else {
+		l.tail = newNode
+	}
+	node.Next = newNode
+	l.size++
+	return newNode
+}
+
+// Delete removes node from the list. Nodes that don't belong to this list
+// (including ones already deleted) are ignored.
+func (l *DoublyLinkedList) Delete(node *DNode) {
+	if node == nil || node.list != l {
+		return
+	}
+
+	// Point the neighbours at each other, skipping node
+	if node.Prev != nil {
+		node.Prev.Next = node.Next
+	} else {
+		l.head = node.Next
+	}
+	if node.Next != nil {
+		node.Next.Prev = node.Prev
+	} else {
+		l.tail = node.Prev
+	}
+
+	// Clear node's own pointers so it doesn't keep the rest of the list
+	// alive, and so a second Delete does nothing
+	node.Prev, node.Next, node.list = nil, nil, nil
+	l.size--
+}
+
+// Len returns the number of values in the list
+func (l *DoublyLinkedList) Len() int {
+	return l.size
+}
+
+// Forward returns the values from head to tail, following Next pointers
+func (l *DoublyLinkedList) Forward() []int {
+	values := make([]int, 0, l.size)
+	for node := l.head; node != nil; node = node.Next {
+		values = append(values, node.Value)
+	}
+	return values
+}
+
+// Backward returns the values from tail to head, following Prev pointers
+func (l *DoublyLinkedList) Backward() []int {
+	values := make([]int, 0, l.size)
+	for node := l.tail; node != nil; node = node.Prev {
+		values = append(values, node.Value)
+	}
+	return values
+}
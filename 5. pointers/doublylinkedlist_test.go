@@ -0,0 +1,91 @@
+package main
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkList fails the test unless l holds expected in both directions
+func checkList(t *testing.T, l *DoublyLinkedList, expected []int) {
+	t.Helper()
+	if got := l.Forward(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Forward() = %v; expected %v", got, expected)
+	}
+	reversed := slices.Clone(expected)
+	slices.Reverse(reversed)
+	if got := l.Backward(); !reflect.DeepEqual(got, reversed) {
+		t.Errorf("Backward() = %v; expected %v", got, reversed)
+	}
+	if l.Len() != len(expected) {
+		t.Errorf("Len() = %d; expected %d", l.Len(), len(expected))
+	}
+}
+
+func TestDoublyLinkedListInsert(t *testing.T) {
+	var list DoublyLinkedList
+	one := list.PushBack(1)
+	list.PushBack(3)
+	list.InsertAfter(one, 2)
+	list.PushBack(4)
+
+	checkList(t, &list, []int{1, 2, 3, 4})
+}
+
+func TestDoublyLinkedListDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		delete   int // Index of the node to delete
+		expected []int
+	}{
+		{"head", 0, []int{2, 3, 4}},
+		{"middle", 2, []int{1, 2, 4}},
+		{"tail", 3, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var list DoublyLinkedList
+			var nodes []*DNode
+			for _, v := range []int{1, 2, 3, 4} {
+				nodes = append(nodes, list.PushBack(v))
+			}
+
+			list.Delete(nodes[tt.delete])
+
+			checkList(t, &list, tt.expected)
+		})
+	}
+}
+
+func TestDoublyLinkedListDeleteAll(t *testing.T) {
+	var list DoublyLinkedList
+	a := list.PushBack(1)
+	b := list.PushBack(2)
+
+	list.Delete(a)
+	list.Delete(b)
+	checkList(t, &list, []int{})
+
+	// The emptied list is still usable
+	list.PushBack(3)
+	checkList(t, &list, []int{3})
+}
+
+func TestDoublyLinkedListForeignNodes(t *testing.T) {
+	var list, other DoublyLinkedList
+	list.PushBack(1)
+	deleted := list.PushBack(2)
+	list.Delete(deleted)
+	foreign := other.PushBack(9)
+
+	list.Delete(deleted) // Deleting twice does nothing
+	list.Delete(foreign) // Nodes from another list are ignored
+	list.Delete(nil)
+	if n := list.InsertAfter(foreign, 5); n != nil {
+		t.Errorf("InsertAfter(foreign node) = %v; expected nil", n)
+	}
+
+	checkList(t, &list, []int{1})
+	checkList(t, &other, []int{9})
+}
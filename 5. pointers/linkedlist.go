@@ -0,0 +1,70 @@
+package main
+
+// Node is one element of a LinkedList. Next points to the following node,
+// or is nil for the last one.
+type Node struct {
+	Value int
+	Next  *Node
+}
+
+// LinkedList is a singly linked list of ints. Each node only knows where
+// the next one is, so the list is a chain of pointers starting at head.
+// The zero value is an empty list ready to use.
+type LinkedList struct {
+	head *Node
+	size int
+}
+
+// PushFront adds value at the start of the list
+func (l *LinkedList) PushFront(value int) {
+	// The new node points at the old head, then becomes the head
+	l.head = &Node{Value: value, Next: l.head}
+	l.size++
+}
+
+// PushBack adds value at the end of the list. It walks the whole chain to
+// find the last node.
+func (l *LinkedList) PushBack(value int) {
+	node := &Node{Value: value}
+	l.size++
+	if l.head == nil {
+		l.head = node
+		return
+	}
+
+	current := l.head
+	for current.Next != nil {
+		current = current.Next
+	}
+	current.Next = node
+}
+
+// Remove deletes the first node holding value. It reports whether such a
+// node was found.
+func (l *LinkedList) Remove(value int) bool {
+	// link points at the pointer that leads to the current node - first
+	// l.head, then the Next field of each node. Changing *link unhooks a
+	// node, so the head needs no special case.
+	for link := &l.head; *link != nil; link = &(*link).Next {
+		if (*link).Value == value {
+			*link = (*link).Next
+			l.size--
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of values in the list
+func (l *LinkedList) Len() int {
+	return l.size
+}
+
+// ToSlice returns the values from head to tail
+func (l *LinkedList) ToSlice() []int {
+	values := make([]int, 0, l.size)
+	for current := l.head; current != nil; current = current.Next {
+		values = append(values, current.Value)
+	}
+	return values
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLinkedListPush(t *testing.T) {
+	var list LinkedList
+	list.PushBack(2)
+	list.PushBack(3)
+	list.PushFront(1)
+	list.PushFront(0)
+
+	expected := []int{0, 1, 2, 3}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("ToSlice() = %v; expected %v", got, expected)
+	}
+	if list.Len() != 4 {
+		t.Errorf("Len() = %d; expected 4", list.Len())
+	}
+}
+
+func TestLinkedListRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		remove   int
+		found    bool
+		expected []int
+	}{
+		{"head", 1, true, []int{2, 3, 4}},
+		{"middle", 3, true, []int{1, 2, 4}},
+		{"tail", 4, true, []int{1, 2, 3}},
+		{"missing", 9, false, []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var list LinkedList
+			for _, v := range []int{1, 2, 3, 4} {
+				list.PushBack(v)
+			}
+
+			if got := list.Remove(tt.remove); got != tt.found {
+				t.Errorf("Remove(%d) = %v; expected %v", tt.remove, got, tt.found)
+			}
+			if got := list.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ToSlice() = %v; expected %v", got, tt.expected)
+			}
+			if list.Len() != len(tt.expected) {
+				t.Errorf("Len() = %d; expected %d", list.Len(), len(tt.expected))
+			}
+		})
+	}
+}
+
+func TestLinkedListRemoveFirstMatchOnly(t *testing.T) {
+	var list LinkedList
+	for _, v := range []int{5, 7, 5} {
+		list.PushBack(v)
+	}
+
+	list.Remove(5)
+
+	expected := []int{7, 5}
+	if got := list.ToSlice(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("ToSlice() = %v; expected %v", got, expected)
+	}
+}
+
+func TestLinkedListEmpty(t *testing.T) {
+	var list LinkedList
+
+	if list.Remove(1) {
+		t.Error("Remove on an empty list returned true")
+	}
+	if got := list.ToSlice(); len(got) != 0 || list.Len() != 0 {
+		t.Errorf("ToSlice() = %v, Len() = %d; expected an empty list", got, list.Len())
+	}
+
+	// Removing the only value leaves an empty list that still works
+	list.PushBack(1)
+	list.Remove(1)
+	list.PushBack(2)
+	if got := list.ToSlice(); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("ToSlice() = %v; expected [2]", got)
+	}
+}
@@ -29,6 +29,24 @@ func main() {
 
 	// Example 8: Common use cases
 	commonUseCases()
+
+	// Example 9: A linked list
+	linkedListExample()
+
+	// Example 10: Swapping values
+	swapExample()
+
+	// Example 11: Comparing addresses
+	compareAddresses()
+
+	// Example 12: A doubly linked list
+	doublyLinkedListExample()
+
+	// Example 13: A generic stack
+	stackExample()
+
+	// Example 14: A binary search tree
+	bstExample()
 }
 
 // Example 1: Basic pointer concepts
@@ -203,3 +221,95 @@ func commonUseCases() {
 
 	fmt.Println()
 }
+
+// Example 9: A linked list
+func linkedListExample() {
+	fmt.Println("9. A Linked List (Nodes Joined by Pointers):")
+
+	var list LinkedList // The zero value is an empty list
+	list.PushBack(2)
+	list.PushBack(3)
+	list.PushFront(1)
+	fmt.Printf("List: %v (size %d)\n", list.ToSlice(), list.Len())
+
+	list.Remove(2) // Node 1's Next now skips straight to node 3
+	fmt.Printf("After Remove(2): %v (size %d)\n", list.ToSlice(), list.Len())
+	fmt.Println()
+}
+
+// Example 10: Swapping values
+func swapExample() {
+	fmt.Println("10. Swapping Values Through Pointers:")
+
+	a, b := 1, 2
+	SwapInts(&a, &b) // Needs the addresses - a copy of a and b couldn't be changed
+	fmt.Printf("After SwapInts: a=%d, b=%d\n", a, b)
+
+	first, second := "hello", "world"
+	Swap(&first, &second) // The generic version works for any type
+	fmt.Printf("After Swap: first=%s, second=%s\n", first, second)
+	fmt.Println()
+}
+
+// Example 11: Comparing addresses
+func compareAddresses() {
+	fmt.Println("11. Comparing Addresses (Identity vs Equality):")
+
+	a := 42
+	b := 42 // Equal value, but a separate variable
+	alias := &a
+
+	fmt.Printf("a == b: %v (values are equal)\n", a == b)
+	fmt.Printf("&a = %p, &b = %p\n", &a, &b)
+	fmt.Printf("SameAddress(&a, &b): %v (different variables)\n", SameAddress(&a, &b))
+	fmt.Printf("SameAddress(alias, &a): %v (alias points to a)\n", SameAddress(alias, &a))
+	fmt.Println()
+}
+
+// Example 12: A doubly linked list
+func doublyLinkedListExample() {
+	fmt.Println("12. A Doubly Linked List (Prev and Next Pointers):")
+
+	var list DoublyLinkedList
+	first := list.PushBack(1)
+	list.PushBack(3)
+	middle := list.InsertAfter(first, 2) // Keep the node to delete it later
+	fmt.Printf("Forward: %v, Backward: %v\n", list.Forward(), list.Backward())
+
+	list.Delete(middle) // No search needed - the node knows its neighbours
+	fmt.Printf("After deleting 2: Forward: %v, Backward: %v\n", list.Forward(), list.Backward())
+	fmt.Println()
+}
+
+// Example 13: A generic stack
+func stackExample() {
+	fmt.Println("13. A Generic Stack (Pointer Receivers):")
+
+	var stack Stack[string]
+	stack.Push("first")
+	stack.Push("second")
+	stack.Push("third")
+
+	top, _ := stack.Peek()
+	fmt.Printf("Peek: %s (size %d)\n", top, stack.Len())
+
+	// Pop returns ok=false once the stack is empty
+	for item, ok := stack.Pop(); ok; item, ok = stack.Pop() {
+		fmt.Println("Popped:", item)
+	}
+	fmt.Println()
+}
+
+// Example 14: A binary search tree
+func bstExample() {
+	fmt.Println("14. A Binary Search Tree (Nodes with Left and Right Pointers):")
+
+	var tree BST[int]
+	for _, n := range []int{50, 30, 70, 20, 40, 60, 80, 30} {
+		tree.Insert(n) // The second 30 is ignored
+	}
+
+	fmt.Printf("In order: %v (size %d)\n", tree.InOrder(), tree.Len())
+	fmt.Printf("Contains 60? %v, Contains 65? %v\n", tree.Contains(60), tree.Contains(65))
+	fmt.Println()
+}
@@ -0,0 +1,40 @@
+package main
+
+// Stack is a last-in, first-out collection backed by a slice. Push and Pop
+// have pointer receivers because they change the stack's slice. The zero
+// value is an empty stack ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds item to the top of the stack
+func (s *Stack[T]) Push(item T) {
+	s.items = append(s.items, item)
+}
+
+// Pop removes and returns the top item. ok is false if the stack is empty.
+func (s *Stack[T]) Pop() (item T, ok bool) {
+	if len(s.items) == 0 {
+		return item, false // item is T's zero value
+	}
+	last := len(s.items) - 1
+	item = s.items[last]
+	var zero T
+	s.items[last] = zero // Don't keep a reference to the popped item
+	s.items = s.items[:last]
+	return item, true
+}
+
+// Peek returns the top item without removing it. ok is false if the stack
+// is empty.
+func (s *Stack[T]) Peek() (item T, ok bool) {
+	if len(s.items) == 0 {
+		return item, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of items on the stack
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
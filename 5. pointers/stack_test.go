@@ -0,0 +1,67 @@
+package main
+
+import "testing"
+
+func TestStackLIFO(t *testing.T) {
+	var s Stack[int]
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+
+	if top, ok := s.Peek(); !ok || top != 3 {
+		t.Errorf("Peek() = %d, %v; expected 3, true", top, ok)
+	}
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d; expected 3 (Peek must not remove)", s.Len())
+	}
+
+	for _, expected := range []int{3, 2, 1} {
+		got, ok := s.Pop()
+		if !ok || got != expected {
+			t.Errorf("Pop() = %d, %v; expected %d, true", got, ok, expected)
+		}
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d; expected 0", s.Len())
+	}
+}
+
+func TestStackEmpty(t *testing.T) {
+	var s Stack[string]
+
+	if got, ok := s.Pop(); ok || got != "" {
+		t.Errorf("Pop() on empty stack = %q, %v; expected \"\", false", got, ok)
+	}
+	if got, ok := s.Peek(); ok || got != "" {
+		t.Errorf("Peek() on empty stack = %q, %v; expected \"\", false", got, ok)
+	}
+
+	// Still usable after popping everything
+	s.Push("a")
+	s.Pop()
+	if _, ok := s.Pop(); ok {
+		t.Error("Pop() after emptying the stack returned ok=true")
+	}
+}
+
+func TestStackTypes(t *testing.T) {
+	// Each instantiation is its own type with its own items
+	var words Stack[string]
+	var points Stack[struct{ X, Y int }]
+	var ptrs Stack[*int]
+
+	words.Push("go")
+	points.Push(struct{ X, Y int }{1, 2})
+	n := 7
+	ptrs.Push(&n)
+
+	if w, _ := words.Pop(); w != "go" {
+		t.Errorf("words.Pop() = %q; expected %q", w, "go")
+	}
+	if p, _ := points.Pop(); p.X != 1 || p.Y != 2 {
+		t.Errorf("points.Pop() = %v; expected {1 2}", p)
+	}
+	if p, _ := ptrs.Pop(); p != &n {
+		t.Errorf("ptrs.Pop() = %p; expected %p", p, &n)
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+// SwapInts exchanges the values that a and b point to. It panics if either
+// pointer is nil.
+func SwapInts(a, b *int) {
+	if a == nil || b == nil {
+		panic("SwapInts: nil pointer")
+	}
+	*a, *b = *b, *a
+}
+
+// Swap is the generic version of SwapInts: it works for pointers to any
+// type, e.g. Swap(&s1, &s2) for two strings. It panics if either pointer
+// is nil.
+func Swap[T any](a, b *T) {
+	if a == nil || b == nil {
+		panic("Swap: nil pointer")
+	}
+	*a, *b = *b, *a
+}
@@ -0,0 +1,62 @@
+package main
+
+import "testing"
+
+func TestSwapInts(t *testing.T) {
+	x, y := 1, 2
+
+	SwapInts(&x, &y)
+
+	if x != 2 || y != 1 {
+		t.Errorf("after SwapInts: x=%d, y=%d; expected x=2, y=1", x, y)
+	}
+}
+
+func TestSwap(t *testing.T) {
+	s1, s2 := "left", "right"
+	Swap(&s1, &s2)
+	if s1 != "right" || s2 != "left" {
+		t.Errorf("after Swap: s1=%q, s2=%q; expected s1=%q, s2=%q", s1, s2, "right", "left")
+	}
+
+	type point struct{ X, Y int }
+	p1, p2 := point{1, 2}, point{3, 4}
+	Swap(&p1, &p2)
+	if p1 != (point{3, 4}) || p2 != (point{1, 2}) {
+		t.Errorf("after Swap: p1=%v, p2=%v; expected p1={3 4}, p2={1 2}", p1, p2)
+	}
+}
+
+func TestSwapSamePointer(t *testing.T) {
+	x := 5
+
+	Swap(&x, &x)
+
+	if x != 5 {
+		t.Errorf("after Swap(&x, &x): x=%d; expected 5", x)
+	}
+}
+
+func TestSwapNilPanics(t *testing.T) {
+	x := 1
+	tests := []struct {
+		name string
+		swap func()
+	}{
+		{"SwapInts first nil", func() { SwapInts(nil, &x) }},
+		{"SwapInts second nil", func() { SwapInts(&x, nil) }},
+		{"Swap first nil", func() { Swap(nil, &x) }},
+		{"Swap second nil", func() { Swap(&x, nil) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic for a nil pointer")
+				}
+			}()
+			tt.swap()
+		})
+	}
+}
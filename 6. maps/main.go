@@ -1,34 +1,47 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+
+	"examples"
+)
 
 func main() {
 	fmt.Println("=== Understanding Maps in Go ===")
 	fmt.Println()
 
+	demos := examples.NewRegistry()
+
 	// Example 1: Creating and initializing maps
-	creatingMaps()
+	demos.Register("maps/creating", examples.Plain(creatingMaps))
 
 	// Example 2: Adding and accessing elements
-	addingAndAccessing()
+	demos.Register("maps/accessing", examples.Plain(addingAndAccessing))
 
 	// Example 3: Updating and deleting elements
-	updatingAndDeleting()
+	demos.Register("maps/updating", examples.Plain(updatingAndDeleting))
 
 	// Example 4: Checking if key exists
-	checkingKeys()
+	demos.Register("maps/checking", examples.Plain(checkingKeys))
 
 	// Example 5: Iterating over maps
-	iteratingMaps()
+	demos.Register("maps/iterating", examples.Plain(iteratingMaps))
 
 	// Example 6: Maps with different types
-	differentTypes()
+	demos.Register("maps/types", examples.Plain(differentTypes))
 
 	// Example 7: Maps are reference types
-	referenceTypes()
+	demos.Register("maps/references", examples.Plain(referenceTypes))
 
 	// Example 8: Practical examples
-	practicalExamples()
+	demos.Register("maps/practical", examples.Plain(practicalExamples))
+
+	// Example 9: Generic map helpers
+	demos.Register("maps/helpers", examples.Plain(mapHelpers))
+
+	// Runs everything, or just what -demo picks (e.g. -demo maps/iterating)
+	examples.Main(demos)
 }
 
 // Example 1: Creating and initializing maps
@@ -79,6 +92,9 @@ func addingAndAccessing() {
 	// Accessing non-existent key returns zero value
 	fmt.Printf("User 999: '%s' (empty string - zero value)\n", users[999])
 
+	// GetOrDefault (maputil.go) lets you pick what "missing" looks like
+	fmt.Printf("User 999: '%s' (GetOrDefault)\n", GetOrDefault(users, 999, "<unknown>"))
+
 	fmt.Println()
 }
 
@@ -164,6 +180,12 @@ func iteratingMaps() {
 	// Note: Map iteration order is random!
 	fmt.Println("\nIteration order is random - run again to see different order")
 
+	// Sorting the keys first gives a stable order
+	fmt.Println("\nSorted by name:")
+	for _, name := range SortedKeys(grades) {
+		fmt.Printf("  %s: %d\n", name, grades[name])
+	}
+
 	fmt.Println()
 }
 
@@ -222,6 +244,20 @@ func referenceTypes() {
 	fmt.Printf("After function call: %v\n", original)
 	fmt.Println("Function modified original! (no pointer needed)")
 
+	// Sharing a map between goroutines needs a lock (see syncmap.go)
+	var visits SyncMap[string, int]
+	var wg sync.WaitGroup
+	for _, page := range []string{"home", "about", "contact"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			visits.Store(page, len(page))
+		}()
+	}
+	wg.Wait()
+	count, ok := visits.Load("about")
+	fmt.Printf("SyncMap written by 3 goroutines: about=%d (found: %v)\n", count, ok)
+
 	fmt.Println()
 }
 
@@ -242,6 +278,9 @@ func practicalExamples() {
 	}
 	fmt.Printf("Word count: %v\n", wordCount)
 
+	// Sorted by frequency instead of random map order (see wordcount.go)
+	fmt.Printf("Top 2 words: %v\n", TopWords("hello world hello go world hello", 2))
+
 	// Character frequency
 	str := "hello"
 	charFreq := make(map[rune]int)
@@ -282,3 +321,27 @@ func practicalExamples() {
 
 	fmt.Println()
 }
+
+// Example 9: Generic map helpers (see maputil.go)
+func mapHelpers() {
+	fmt.Println("9. Generic Map Helpers:")
+
+	before := map[string]int{"apples": 10, "bananas": 5, "oranges": 8}
+	after := map[string]int{"apples": 15, "oranges": 8, "grapes": 3}
+	added, removed, changed := DiffMaps(before, after)
+	fmt.Printf("DiffMaps: added=%v removed=%v changed=%v\n", added, removed, changed)
+
+	words := []string{"hello", "world", "hello", "go", "world", "hello"}
+	if word, count, ok := MostCommon(words); ok {
+		fmt.Printf("MostCommon: %q appears %d times\n", word, count)
+	}
+
+	defaults := map[string]string{"theme": "light", "lang": "en"}
+	overrides := map[string]string{"theme": "dark"}
+	fmt.Printf("MergeMaps: %v (later maps win)\n", MergeMaps(defaults, overrides))
+
+	colors := map[string]string{"red": "#FF0000", "green": "#00FF00"}
+	fmt.Printf("InvertMap: %v\n", InvertMap(colors))
+
+	fmt.Println()
+}
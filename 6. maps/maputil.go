@@ -0,0 +1,123 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Generic helpers for working with maps
+// K must be comparable because it is used as a map key
+
+// DiffMaps compares map a (before) with map b (after)
+//   - added:   keys only in b, with b's values
+//   - removed: keys only in a, with a's values
+//   - changed: keys in both whose values differ, with b's (new) values
+//
+// None of the returned maps are nil, so they are always safe to range over.
+func DiffMaps[K comparable, V comparable](a, b map[K]V) (added, removed, changed map[K]V) {
+	added = make(map[K]V)
+	removed = make(map[K]V)
+	changed = make(map[K]V)
+
+	for key, oldValue := range a {
+		newValue, ok := b[key]
+		if !ok {
+			removed[key] = oldValue
+		} else if newValue != oldValue {
+			changed[key] = newValue
+		}
+	}
+
+	for key, newValue := range b {
+		if _, ok := a[key]; !ok {
+			added[key] = newValue
+		}
+	}
+
+	return added, removed, changed
+}
+
+// MostCommon returns the element that appears most often in s and its count
+// When several elements share the highest count, the one seen first wins.
+// ok is false for an empty slice.
+func MostCommon[T comparable](s []T) (value T, count int, ok bool) {
+	counts := make(map[T]int)
+	for _, item := range s {
+		counts[item]++
+	}
+
+	// Walk the slice (not the map) so ties resolve by first occurrence;
+	// map iteration order is random
+	for _, item := range s {
+		if counts[item] > count {
+			value, count, ok = item, counts[item], true
+		}
+	}
+	return value, count, ok
+}
+
+// MergeMaps combines all the given maps into a new map
+// When a key appears in several maps, the value from the later map wins.
+// The inputs are never modified, and the result is never nil.
+func MergeMaps[K comparable, V any](maps ...map[K]V) map[K]V {
+	size := 0
+	for _, m := range maps {
+		size += len(m)
+	}
+
+	merged := make(map[K]V, size)
+	for _, m := range maps {
+		for key, value := range m {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// InvertMap swaps keys and values, e.g. {"a": 1} becomes {1: "a"}
+// If several keys share the same value, only one of them survives. Which
+// one is not predictable, because map iteration order is random.
+func InvertMap[K, V comparable](m map[K]V) map[V]K {
+	inverted := make(map[V]K, len(m))
+	for key, value := range m {
+		inverted[value] = key // Last write wins on duplicate values
+	}
+	return inverted
+}
+
+// Keys returns the keys of m in no particular order
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns the values of m in no particular order
+func Values[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, value := range m {
+		values = append(values, value)
+	}
+	return values
+}
+
+// SortedKeys returns the keys of m in ascending order, so ranging over
+// them gives the same order every run. cmp.Ordered covers every type that
+// supports < (integers, floats and strings).
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+// GetOrDefault returns the value stored under key, or def if key is missing.
+// Unlike m[key], a missing key can't be confused with a stored zero value.
+// Reading from a nil map is fine, so m may be nil.
+func GetOrDefault[K comparable, V any](m map[K]V, key K, def V) V {
+	if value, ok := m[key]; ok {
+		return value
+	}
+	return def
+}
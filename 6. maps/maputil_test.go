@@ -0,0 +1,187 @@
+package main
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestDiffMaps(t *testing.T) {
+	before := map[string]int{"apples": 10, "bananas": 5, "oranges": 8}
+	after := map[string]int{"apples": 15, "oranges": 8, "grapes": 3}
+
+	added, removed, changed := DiffMaps(before, after)
+
+	if want := map[string]int{"grapes": 3}; !reflect.DeepEqual(added, want) {
+		t.Errorf("added = %v; expected %v", added, want)
+	}
+	if want := map[string]int{"bananas": 5}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("removed = %v; expected %v", removed, want)
+	}
+	if want := map[string]int{"apples": 15}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("changed = %v; expected %v", changed, want)
+	}
+}
+
+func TestDiffMapsIdentical(t *testing.T) {
+	m := map[int]string{1: "Alice", 2: "Bob"}
+
+	added, removed, changed := DiffMaps(m, m)
+
+	if len(added) != 0 || len(removed) != 0 || len(changed) != 0 {
+		t.Errorf("DiffMaps(m, m) = %v, %v, %v; expected all empty", added, removed, changed)
+	}
+}
+
+func TestDiffMapsNilInputs(t *testing.T) {
+	added, removed, changed := DiffMaps(nil, map[string]bool{"darkMode": true})
+
+	if len(added) != 1 || !added["darkMode"] {
+		t.Errorf("added = %v; expected map[darkMode:true]", added)
+	}
+	if removed == nil || changed == nil {
+		t.Error("removed and changed should be empty, non-nil maps")
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	words := []string{"hello", "world", "hello", "go", "world", "hello"}
+
+	value, count, ok := MostCommon(words)
+	if !ok || value != "hello" || count != 3 {
+		t.Errorf("MostCommon(%v) = (%q, %d, %v); expected (\"hello\", 3, true)", words, value, count, ok)
+	}
+}
+
+func TestMostCommonTie(t *testing.T) {
+	numbers := []int{3, 1, 1, 3, 2}
+
+	value, count, ok := MostCommon(numbers)
+	if !ok || value != 3 || count != 2 {
+		t.Errorf("MostCommon(%v) = (%d, %d, %v); expected (3, 2, true) - first to appear wins", numbers, value, count, ok)
+	}
+}
+
+func TestMostCommonEmpty(t *testing.T) {
+	value, count, ok := MostCommon([]string{})
+	if ok || value != "" || count != 0 {
+		t.Errorf("MostCommon(empty) = (%q, %d, %v); expected (\"\", 0, false)", value, count, ok)
+	}
+}
+
+func TestMergeMaps(t *testing.T) {
+	defaults := map[string]string{"theme": "light", "lang": "en"}
+	user := map[string]string{"theme": "dark"}
+	session := map[string]string{"lang": "fr", "tab": "home"}
+
+	merged := MergeMaps(defaults, user, session)
+
+	expected := map[string]string{"theme": "dark", "lang": "fr", "tab": "home"}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("MergeMaps = %v; expected %v", merged, expected)
+	}
+
+	// Inputs must be untouched
+	if !reflect.DeepEqual(defaults, map[string]string{"theme": "light", "lang": "en"}) {
+		t.Errorf("MergeMaps modified its first input: %v", defaults)
+	}
+	if len(user) != 1 || len(session) != 2 {
+		t.Errorf("MergeMaps modified its inputs: %v, %v", user, session)
+	}
+}
+
+func TestMergeMapsNoInputs(t *testing.T) {
+	merged := MergeMaps[string, int]()
+
+	if merged == nil {
+		t.Fatal("MergeMaps() returned nil; expected an empty map")
+	}
+	if len(merged) != 0 {
+		t.Errorf("MergeMaps() = %v; expected empty", merged)
+	}
+	merged["safe"] = 1 // Writing to a nil map would panic
+}
+
+func TestInvertMap(t *testing.T) {
+	got := InvertMap(map[string]int{"a": 1, "b": 2})
+
+	expected := map[int]string{1: "a", 2: "b"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("InvertMap = %v; expected %v", got, expected)
+	}
+}
+
+func TestInvertMapCollision(t *testing.T) {
+	got := InvertMap(map[string]int{"a": 1, "b": 1, "c": 2})
+
+	if len(got) != 2 {
+		t.Fatalf("InvertMap = %v; expected 2 keys", got)
+	}
+	// Either "a" or "b" may win - both are valid results
+	if got[1] != "a" && got[1] != "b" {
+		t.Errorf("got[1] = %q; expected \"a\" or \"b\"", got[1])
+	}
+	if got[2] != "c" {
+		t.Errorf("got[2] = %q; expected \"c\"", got[2])
+	}
+}
+
+func TestKeysAndValues(t *testing.T) {
+	grades := map[string]int{"Alice": 95, "Bob": 87, "Charlie": 92}
+
+	keys := Keys(grades)
+	slices.Sort(keys)
+	if !reflect.DeepEqual(keys, []string{"Alice", "Bob", "Charlie"}) {
+		t.Errorf("Keys = %v; expected Alice, Bob and Charlie", keys)
+	}
+
+	values := Values(grades)
+	slices.Sort(values)
+	if !reflect.DeepEqual(values, []int{87, 92, 95}) {
+		t.Errorf("Values = %v; expected 87, 92 and 95", values)
+	}
+
+	if got := Keys(map[string]int{}); got == nil || len(got) != 0 {
+		t.Errorf("Keys(empty) = %#v; expected an empty slice", got)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	grades := map[string]int{"Diana": 88, "Alice": 95, "Charlie": 92, "Bob": 87}
+
+	expected := []string{"Alice", "Bob", "Charlie", "Diana"}
+	// Map iteration order changes between runs, so check several times
+	for i := 0; i < 20; i++ {
+		if got := SortedKeys(grades); !reflect.DeepEqual(got, expected) {
+			t.Fatalf("SortedKeys = %v; expected %v", got, expected)
+		}
+	}
+
+	if got := SortedKeys(map[int]bool{3: true, -1: true, 2: false}); !reflect.DeepEqual(got, []int{-1, 2, 3}) {
+		t.Errorf("SortedKeys(int keys) = %v; expected [-1 2 3]", got)
+	}
+}
+
+func TestGetOrDefault(t *testing.T) {
+	stock := map[string]int{"apples": 10, "bananas": 0}
+
+	tests := []struct {
+		name     string
+		m        map[string]int
+		key      string
+		expected int
+	}{
+		{"present key", stock, "apples", 10},
+		{"present key with zero value", stock, "bananas", 0},
+		{"absent key", stock, "grapes", -1},
+		{"nil map", nil, "apples", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetOrDefault(tt.m, tt.key, -1); got != tt.expected {
+				t.Errorf("GetOrDefault(%q) = %d; expected %d", tt.key, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import "sync"
+
+// SyncMap is a map that is safe to use from multiple goroutines.
+// A plain map is not: concurrent writes (or a write during a read) crash
+// the program with "fatal error: concurrent map writes".
+//
+// The zero value is ready to use. A SyncMap must not be copied after first use.
+type SyncMap[K comparable, V any] struct {
+	mu   sync.RWMutex // Many readers OR one writer at a time
+	data map[K]V
+}
+
+// Store sets the value for key
+func (m *SyncMap[K, V]) Store(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+	m.data[key] = value
+}
+
+// Load returns the value for key and whether it was present (comma-ok style)
+func (m *SyncMap[K, V]) Load(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	value, ok := m.data[key]
+	return value, ok
+}
+
+// Delete removes key (deleting a missing key is a no-op, like delete())
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.data, key)
+}
+
+// Range calls f for each key/value pair until f returns false.
+// It holds the read lock, so f must not call Store or Delete on the same map.
+func (m *SyncMap[K, V]) Range(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for key, value := range m.data {
+		if !f(key, value) {
+			return
+		}
+	}
+}
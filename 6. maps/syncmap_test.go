@@ -0,0 +1,76 @@
+package main
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncMapStoreLoadDelete(t *testing.T) {
+	var m SyncMap[string, int]
+
+	if _, ok := m.Load("missing"); ok {
+		t.Error("Load on empty map returned ok = true")
+	}
+
+	m.Store("a", 1)
+	if got, ok := m.Load("a"); !ok || got != 1 {
+		t.Errorf("Load(a) = %d, %v; expected 1, true", got, ok)
+	}
+
+	m.Delete("a")
+	if _, ok := m.Load("a"); ok {
+		t.Error("Load(a) after Delete returned ok = true")
+	}
+}
+
+func TestSyncMapRangeStopsEarly(t *testing.T) {
+	var m SyncMap[int, int]
+	for i := 0; i < 10; i++ {
+		m.Store(i, i)
+	}
+
+	visited := 0
+	m.Range(func(key, value int) bool {
+		visited++
+		return visited < 3
+	})
+
+	if visited != 3 {
+		t.Errorf("Range visited %d entries; expected 3", visited)
+	}
+}
+
+// Run with `go test -race` to check there are no data races
+func TestSyncMapConcurrentCounters(t *testing.T) {
+	const goroutines = 50
+	const increments = 100
+
+	var m SyncMap[int, int]
+	var wg sync.WaitGroup
+
+	// Every goroutine owns one counter, but they all share the same map.
+	// With a plain map this would crash with "concurrent map writes".
+	for id := 0; id < goroutines; id++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				count, _ := m.Load(id)
+				m.Store(id, count+1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	m.Range(func(id, count int) bool {
+		if count != increments {
+			t.Errorf("counter %d = %d; expected %d", id, count, increments)
+		}
+		total += count
+		return true
+	})
+	if total != goroutines*increments {
+		t.Errorf("total = %d; expected %d", total, goroutines*increments)
+	}
+}
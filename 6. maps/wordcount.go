@@ -0,0 +1,49 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// WordCount is one word and how many times it appeared
+type WordCount struct {
+	Word  string
+	Count int
+}
+
+// TopWords counts the words in text and returns the n most frequent,
+// highest count first. Words are split on whitespace and lowercased, so
+// "Go" and "go" count as the same word. Words with the same count are
+// sorted alphabetically, which makes the result the same on every run
+// even though map iteration order is random.
+//
+// If n is larger than the number of distinct words, all of them are returned.
+func TopWords(text string, n int) []WordCount {
+	if n <= 0 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, word := range strings.Fields(text) {
+		counts[strings.ToLower(word)]++
+	}
+
+	// Maps can't be sorted, so copy the entries into a slice first
+	result := make([]WordCount, 0, len(counts))
+	for word, count := range counts {
+		result = append(result, WordCount{Word: word, Count: count})
+	}
+
+	slices.SortFunc(result, func(a, b WordCount) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count) // Higher count first
+		}
+		return cmp.Compare(a.Word, b.Word) // Tie: alphabetical
+	})
+
+	if n < len(result) {
+		result = result[:n]
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTopWords(t *testing.T) {
+	paragraph := `Go is simple. Go is fast
+	and the gopher is happy because Go compiles fast and
+	the gopher likes maps`
+
+	tests := []struct {
+		name     string
+		n        int
+		expected []WordCount
+	}{
+		{"top 1", 1, []WordCount{{"go", 3}}},
+		{
+			"ties broken alphabetically",
+			6,
+			[]WordCount{{"go", 3}, {"is", 3}, {"and", 2}, {"fast", 2}, {"gopher", 2}, {"the", 2}},
+		},
+		{"zero", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Map order is random, so repeat to catch unstable sorting
+			for i := 0; i < 20; i++ {
+				if got := TopWords(paragraph, tt.n); !reflect.DeepEqual(got, tt.expected) {
+					t.Fatalf("TopWords(n=%d) = %v; expected %v", tt.n, got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestTopWordsFewerWordsThanN(t *testing.T) {
+	got := TopWords("b a B", 10)
+	expected := []WordCount{{"b", 2}, {"a", 1}}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("TopWords = %v; expected %v", got, expected)
+	}
+}
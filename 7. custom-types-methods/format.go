@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// FormatUSD formats a dollar amount with thousands separators, e.g.
+// "$1,234.56", or "-$1,234.56" for negative amounts.
+//
+// The amount is rounded to the nearest cent, with halves rounded away from
+// zero ($0.125 -> "$0.13"). Because float64 can't store most decimals
+// exactly, a value that looks like a half cent may really be just below
+// it: 1.005 is stored as 1.00499999... and formats as "$1.00". Use Money
+// when exact cents matter.
+//
+// NaN and ±Inf aren't amounts of money; they come back as strconv writes
+// them ("NaN", "+Inf", "-Inf").
+func FormatUSD(amount float64) string {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+	// The cents might not fit in an int64, so format the float directly
+	if math.Abs(amount) >= maxCentsAmount {
+		sign := ""
+		if amount < 0 {
+			sign = "-"
+		}
+		dollars := strconv.FormatFloat(math.Abs(amount), 'f', 0, 64)
+		return sign + "$" + groupDigits(dollars) + ".00"
+	}
+
+	cents := int64(math.Round(amount * 100))
+
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	// Checked after rounding, so -0.001 gives "$0.00" rather than "-$0.00"
+	return fmt.Sprintf("%s$%s.%02d", sign, groupThousands(cents/100), cents%100)
+}
+
+// maxCentsAmount is where FormatUSD stops counting in int64 cents, which
+// overflow at about 9.2e16 dollars. From 2^52 (about 4.5e15) up every
+// float64 is a whole number, so its digits can be written out directly
+// without losing any cents.
+const maxCentsAmount = 1 << 52
+
+// groupThousands writes n (which must not be negative) with a comma
+// between every group of three digits: 1234567 -> "1,234,567"
+func groupThousands(n int64) string {
+	return groupDigits(strconv.FormatInt(n, 10))
+}
+
+// groupDigits puts a comma between every group of three in a string of digits
+func groupDigits(digits string) string {
+	// The first group has 1-3 digits, the rest exactly 3
+	first := len(digits) % 3
+	if first == 0 {
+		first = 3
+	}
+	result := digits[:first]
+	for i := first; i < len(digits); i += 3 {
+		result += "," + digits[i:i+3]
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormatUSD(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		expected string
+	}{
+		{"zero", 0, "$0.00"},
+		{"cents only", 0.5, "$0.50"},
+		{"under a thousand", 999.99, "$999.99"},
+		{"thousands", 1234.56, "$1,234.56"},
+		{"exact thousand", 1000, "$1,000.00"},
+		{"millions", 1234567.89, "$1,234,567.89"},
+		{"billions", 9876543210, "$9,876,543,210.00"},
+		{"negative", -1234.56, "-$1,234.56"},
+		{"negative cents", -0.75, "-$0.75"},
+		{"rounds down", 2.344, "$2.34"},
+		{"rounds up", 2.346, "$2.35"},
+		{"half rounds away from zero", 0.125, "$0.13"},
+		{"negative half rounds away from zero", -0.125, "-$0.13"},
+		{"rounding carries into dollars", 999.999, "$1,000.00"},
+		{"tiny negative rounds to zero", -0.001, "$0.00"},
+		{"whole-dollar floats", 9999999999999998, "$9,999,999,999,999,998.00"},
+		{"near the int64 limit", 92233720368547744, "$92,233,720,368,547,744.00"}, // Exactly representable
+		{"too many cents for an int64", 9.3e16, "$93,000,000,000,000,000.00"},
+		{"huge negative", -1e20, "-$100,000,000,000,000,000,000.00"},
+		{"not a number", math.NaN(), "NaN"},
+		{"positive infinity", math.Inf(1), "+Inf"},
+		{"negative infinity", math.Inf(-1), "-Inf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatUSD(tt.amount); got != tt.expected {
+				t.Errorf("FormatUSD(%v) = %q; expected %q", tt.amount, got, tt.expected)
+			}
+		})
+	}
+}
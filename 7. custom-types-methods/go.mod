@@ -0,0 +1,3 @@
+module custom-types-methods
+
+go 1.23.0
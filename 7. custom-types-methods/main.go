@@ -1,8 +1,10 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"regexp"
 )
 
 type Person struct {
@@ -25,8 +27,20 @@ func (p *Person) haveBirthday() {
 	fmt.Printf("%s is now %d years old!\n", p.fullName(), p.age)
 }
 
-func (p *Person) updateEmail(newEmail string) {
+// ErrInvalidEmail is returned when an email address doesn't look like local@domain.tld
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// emailPattern is a deliberately simple check: something@something.tld
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[a-zA-Z]{2,}$`)
+
+// UpdateEmail validates the address before changing it
+// On failure the old email is kept and ErrInvalidEmail is returned
+func (p *Person) UpdateEmail(newEmail string) error {
+	if !emailPattern.MatchString(newEmail) {
+		return fmt.Errorf("%w: %q", ErrInvalidEmail, newEmail)
+	}
 	p.email = newEmail
+	return nil
 }
 
 type Rectangle struct {
@@ -69,14 +83,14 @@ func NewBankAccount(owner string, initialBalance float64) *BankAccount {
 func (ba *BankAccount) deposit(amount float64) {
 	if amount > 0 {
 		ba.balance += amount
-		fmt.Printf("Deposited $%.2f. New balance: $%.2f\n", amount, ba.balance)
+		fmt.Printf("Deposited %s. New balance: %s\n", FormatUSD(amount), FormatUSD(ba.balance))
 	}
 }
 
 func (ba *BankAccount) withdraw(amount float64) bool {
 	if amount > 0 && amount <= ba.balance {
 		ba.balance -= amount
-		fmt.Printf("Withdrew $%.2f. New balance: $%.2f\n", amount, ba.balance)
+		fmt.Printf("Withdrew %s. New balance: %s\n", FormatUSD(amount), FormatUSD(ba.balance))
 		return true
 	}
 	fmt.Println("Insufficient funds or invalid amount")
@@ -88,7 +102,7 @@ func (ba BankAccount) getBalance() float64 {
 }
 
 func (ba BankAccount) displayInfo() {
-	fmt.Printf("Account Owner: %s, Balance: $%.2f\n", ba.owner, ba.balance)
+	fmt.Printf("Account Owner: %s, Balance: %s\n", ba.owner, FormatUSD(ba.balance))
 }
 
 type Address struct {
@@ -97,16 +111,44 @@ type Address struct {
 	country string
 }
 
+// FullAddress joins the parts of the address on one line
+func (a Address) FullAddress() string {
+	return fmt.Sprintf("%s, %s, %s", a.street, a.city, a.country)
+}
+
+// Employee embeds Address: listing the type without a field name makes
+// Address's fields and methods available directly on Employee, so
+// emp.city and emp.FullAddress() work as well as emp.Address.city
 type Employee struct {
-	name    string
-	age     int
-	salary  float64
-	address Address
+	name   string
+	title  string
+	age    int
+	salary float64
+	Address
 }
 
 func (e Employee) displayDetails() {
-	fmt.Printf("Employee: %s, Age: %d, Salary: $%.2f\n", e.name, e.age, e.salary)
-	fmt.Printf("Address: %s, %s, %s\n", e.address.street, e.address.city, e.address.country)
+	fmt.Printf("Employee: %s (%s), Age: %d, Salary: %s\n", e.name, e.title, e.age, FormatUSD(e.salary))
+	fmt.Println("Address:", e.FullAddress()) // Promoted from Address
+}
+
+// ErrNegativeRaise is returned by GiveRaise for a percentage below zero
+var ErrNegativeRaise = errors.New("raise percentage cannot be negative")
+
+// GiveRaise increases the salary by percent (10 means 10%)
+// A negative percent is rejected and the salary is left unchanged
+func (e *Employee) GiveRaise(percent float64) error {
+	if percent < 0 {
+		return fmt.Errorf("%w: %v%%", ErrNegativeRaise, percent)
+	}
+	e.salary *= 1 + percent/100
+	return nil
+}
+
+// Promote gives the employee a new title and salary
+func (e *Employee) Promote(newTitle string, newSalary float64) {
+	e.title = newTitle
+	e.salary = newSalary
 }
 
 type Calculator struct {
@@ -140,7 +182,8 @@ func (c Calculator) getResult() float64 {
 }
 
 func main() {
-	fmt.Println("=== Custom Types and Receiver Functions ===\n")
+	fmt.Println("=== Custom Types and Receiver Functions ===")
+	fmt.Println()
 
 	fmt.Println("1. CREATING STRUCTS:")
 	var person1 Person
@@ -173,8 +216,14 @@ func main() {
 
 	fmt.Println("\n4. UPDATING EMAIL:")
 	fmt.Println("Old email:", person1.email)
-	person1.updateEmail("john.doe@newmail.com")
+	if err := person1.UpdateEmail("john.doe@newmail.com"); err != nil {
+		fmt.Println("Error:", err)
+	}
 	fmt.Println("New email:", person1.email)
+	if err := person1.UpdateEmail("not-an-email"); err != nil {
+		fmt.Println("Error:", err)
+	}
+	fmt.Println("Email unchanged:", person1.email)
 
 	fmt.Println("\n5. RECTANGLE WITH METHODS:")
 	rect := Rectangle{width: 10, height: 5}
@@ -196,14 +245,15 @@ func main() {
 	account.deposit(500.00)
 	account.withdraw(200.00)
 	account.withdraw(2000.00)
-	fmt.Printf("Final balance: $%.2f\n", account.getBalance())
+	fmt.Println("Final balance:", FormatUSD(account.getBalance()))
 
 	fmt.Println("\n9. EMBEDDED STRUCTS:")
 	emp := Employee{
 		name:   "David Wilson",
+		title:  "Software Engineer",
 		age:    28,
 		salary: 75000.00,
-		address: Address{
+		Address: Address{ // The embedded field is named after its type
 			street:  "123 Main St",
 			city:    "New York",
 			country: "USA",
@@ -212,9 +262,10 @@ func main() {
 	emp.displayDetails()
 
 	fmt.Println("\n10. ACCESSING NESTED FIELDS:")
-	fmt.Println("Employee city:", emp.address.city)
-	emp.address.city = "Los Angeles"
-	fmt.Println("Updated city:", emp.address.city)
+	fmt.Println("Employee city:", emp.city) // Promoted field
+	emp.Address.city = "Los Angeles"        // The full path still works
+	fmt.Println("Updated city:", emp.city)
+	fmt.Println("Full address:", emp.FullAddress()) // Promoted method
 
 	fmt.Println("\n11. METHOD CHAINING:")
 	calc := Calculator{}
@@ -233,5 +284,47 @@ func main() {
 		fmt.Printf("%d. %s (%d years old)\n", i+1, person.fullName(), person.age)
 	}
 
+	fmt.Println("\nAs a table:")
+	var rows [][]string
+	for _, person := range people {
+		rows = append(rows, []string{person.fullName(), fmt.Sprint(person.age), person.email})
+	}
+	fmt.Print(FormatTable([]string{"Name", "Age", "Email"}, rows))
+
+	fmt.Println("\n13. MONEY TYPE (NO FLOAT ROUNDING):")
+	floatTotal := 0.0
+	moneyTotal := Money(0)
+	for i := 0; i < 10; i++ {
+		floatTotal += 0.10
+		moneyTotal = moneyTotal.Add(10)
+	}
+	fmt.Printf("Float: 10 x 0.10 = %.17f\n", floatTotal)
+	fmt.Println("Money: 10 x $0.10 =", moneyTotal)
+	price, err := ParseMoney("$12.34")
+	if err != nil {
+		fmt.Println("Error parsing money:", err)
+	} else {
+		fmt.Printf("%s x 3 = %s\n", price, price.Mul(3))
+	}
+
+	fmt.Println("\n14. TEMPERATURE TYPE (VALIDATING CONSTRUCTORS):")
+	boiling, _ := FromCelsius(100)
+	fmt.Printf("Boiling water: %.2f°C = %.2f°F = %.2fK\n",
+		boiling.Celsius(), boiling.Fahrenheit(), boiling.Kelvin())
+	if _, err := FromCelsius(-300); err != nil {
+		fmt.Println("Error:", err)
+	}
+
+	fmt.Println("\n15. RAISES AND PROMOTIONS (Pointer Receivers):")
+	if err := emp.GiveRaise(10); err != nil {
+		fmt.Println("Error:", err)
+	}
+	fmt.Println("After a 10% raise:", FormatUSD(emp.salary))
+	if err := emp.GiveRaise(-5); err != nil {
+		fmt.Println("Error:", err)
+	}
+	emp.Promote("Senior Engineer", 95000)
+	emp.displayDetails()
+
 	fmt.Println("\n=== Program Complete ===")
 }
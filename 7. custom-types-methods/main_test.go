@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestUpdateEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		newEmail string
+		wantErr  bool
+		expected string
+	}{
+		{"valid address", "jane.doe@example.com", false, "jane.doe@example.com"},
+		{"missing @", "jane.example.com", true, "jane@example.com"},
+		{"empty string", "", true, "jane@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Person{firstName: "Jane", lastName: "Doe", email: "jane@example.com"}
+
+			err := p.UpdateEmail(tt.newEmail)
+			if tt.wantErr && !errors.Is(err, ErrInvalidEmail) {
+				t.Errorf("UpdateEmail(%q) error = %v; expected ErrInvalidEmail", tt.newEmail, err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("UpdateEmail(%q) returned unexpected error: %v", tt.newEmail, err)
+			}
+			if p.email != tt.expected {
+				t.Errorf("email = %q; expected %q", p.email, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGiveRaise(t *testing.T) {
+	tests := []struct {
+		name     string
+		percent  float64
+		wantErr  bool
+		expected float64
+	}{
+		{"10 percent", 10, false, 55000},
+		{"zero", 0, false, 50000},
+		{"fractional", 2.5, false, 51250},
+		{"negative rejected", -10, true, 50000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Employee{name: "Ann", salary: 50000}
+
+			err := e.GiveRaise(tt.percent)
+			if tt.wantErr && !errors.Is(err, ErrNegativeRaise) {
+				t.Errorf("GiveRaise(%v) error = %v; expected ErrNegativeRaise", tt.percent, err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("GiveRaise(%v) returned unexpected error: %v", tt.percent, err)
+			}
+			if math.Abs(e.salary-tt.expected) > 1e-9 {
+				t.Errorf("salary = %v; expected %v", e.salary, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPromote(t *testing.T) {
+	e := Employee{name: "Ann", title: "Engineer", salary: 50000}
+
+	e.Promote("Senior Engineer", 70000)
+
+	if e.title != "Senior Engineer" || e.salary != 70000 {
+		t.Errorf("after Promote: title=%q, salary=%v; expected \"Senior Engineer\", 70000", e.title, e.salary)
+	}
+}
+
+func TestEmbeddedAddressPromotion(t *testing.T) {
+	e := Employee{
+		name:    "Ann",
+		Address: Address{street: "1 High St", city: "Leeds", country: "UK"},
+	}
+
+	// Fields and methods of Address can be used directly on Employee
+	if e.city != "Leeds" {
+		t.Errorf("e.city = %q; expected %q", e.city, "Leeds")
+	}
+	expected := "1 High St, Leeds, UK"
+	if got := e.FullAddress(); got != expected {
+		t.Errorf("e.FullAddress() = %q; expected %q", got, expected)
+	}
+
+	// Promoted and full paths refer to the same field
+	e.city = "York"
+	if e.Address.city != "York" {
+		t.Errorf("e.Address.city = %q after setting e.city; expected %q", e.Address.city, "York")
+	}
+	if got := e.Address.FullAddress(); got != "1 High St, York, UK" {
+		t.Errorf("e.Address.FullAddress() = %q; expected %q", got, "1 High St, York, UK")
+	}
+}
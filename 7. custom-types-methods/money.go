@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidMoney is returned by ParseMoney when the input is not a valid amount
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+// Money stores an amount as a whole number of cents
+// Using an integer avoids the rounding errors you get with float64 dollars
+// (0.1 + 0.2 != 0.3 with floats, but 10 + 20 == 30 cents always)
+type Money int64
+
+// Add returns the sum of two amounts
+func (m Money) Add(other Money) Money {
+	return m + other
+}
+
+// Sub returns the difference of two amounts
+func (m Money) Sub(other Money) Money {
+	return m - other
+}
+
+// Mul multiplies the amount by a whole number (e.g. quantity of items)
+func (m Money) Mul(factor int) Money {
+	return m * Money(factor)
+}
+
+// String formats the amount as dollars, e.g. "$12.34" or "-$12.34"
+func (m Money) String() string {
+	// Work on the size as a uint64: -m overflows for the most negative
+	// Money, but its size (2^63) still fits in a uint64
+	sign := ""
+	cents := uint64(m)
+	if m < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
+
+// ParseMoney converts a string like "$12.34", "12.34", "-$5" or "7.5" into Money
+// The dollar sign is optional and at most two decimal places are allowed
+func ParseMoney(s string) (Money, error) {
+	input := strings.TrimSpace(s)
+
+	negative := false
+	if rest, ok := strings.CutPrefix(input, "-"); ok {
+		negative = true
+		input = rest
+	}
+	input = strings.TrimPrefix(input, "$")
+
+	dollarsStr, centsStr, hasDot := strings.Cut(input, ".")
+	if dollarsStr == "" || !isDigits(dollarsStr) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	if hasDot && (len(centsStr) == 0 || len(centsStr) > 2 || !isDigits(centsStr)) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+
+	dollars, err := strconv.ParseInt(dollarsStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	// dollars*100 + 99 cents must still fit in an int64
+	if dollars > (math.MaxInt64-99)/100 {
+		return 0, fmt.Errorf("%w: %q is too large", ErrInvalidMoney, s)
+	}
+
+	// "7.5" means 50 cents, not 5 cents
+	for len(centsStr) < 2 {
+		centsStr += "0"
+	}
+	cents, _ := strconv.ParseInt(centsStr, 10, 64)
+
+	total := Money(dollars*100 + cents)
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// isDigits reports whether s contains only the characters 0-9
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
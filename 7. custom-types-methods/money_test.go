@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoneyArithmetic(t *testing.T) {
+	// Adding 10 cents ten times drifts with float64 but not with Money
+	var total Money
+	for i := 0; i < 10; i++ {
+		total = total.Add(10)
+	}
+	if total != 100 {
+		t.Errorf("10 x $0.10 = %s; expected $1.00", total)
+	}
+
+	if got := Money(1234).Sub(34); got != 1200 {
+		t.Errorf("$12.34 - $0.34 = %s; expected $12.00", got)
+	}
+
+	if got := Money(199).Mul(3); got != 597 {
+		t.Errorf("$1.99 * 3 = %s; expected $5.97", got)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Money
+		expected string
+	}{
+		{"dollars and cents", 1234, "$12.34"},
+		{"zero", 0, "$0.00"},
+		{"cents only", 5, "$0.05"},
+		{"negative", -1234, "-$12.34"},
+		{"negative cents only", -7, "-$0.07"},
+		{"largest", math.MaxInt64, "$92233720368547758.07"},
+		{"most negative", math.MinInt64, "-$92233720368547758.08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.String(); got != tt.expected {
+				t.Errorf("Money(%d).String() = %q; expected %q", int64(tt.amount), got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMoneyNegativeArithmetic(t *testing.T) {
+	result := Money(500).Sub(1250)
+	if result != -750 {
+		t.Errorf("$5.00 - $12.50 = %s; expected -$7.50", result)
+	}
+	if got := result.Mul(-2); got != 1500 {
+		t.Errorf("-$7.50 * -2 = %s; expected $15.00", got)
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Money
+	}{
+		{"with dollar sign", "$12.34", 1234},
+		{"without dollar sign", "12.34", 1234},
+		{"whole dollars", "$12", 1200},
+		{"one decimal place", "7.5", 750},
+		{"negative with dollar sign", "-$5.25", -525},
+		{"negative without dollar sign", "-5.25", -525},
+		{"largest allowed", "$92233720368547757.99", 9223372036854775799},
+		{"most negative allowed", "-$92233720368547757.99", -9223372036854775799},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.input)
+			if err != nil {
+				t.Fatalf("ParseMoney(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseMoney(%q) = %s; expected %s", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseMoneyInvalid(t *testing.T) {
+	inputs := []string{"", "$", "abc", "$12.345", "12.", "$1.2x", "$$5",
+		"$100000000000000000",   // Cents overflow an int64
+		"$92233720368547758.08", // Would wrap around to math.MinInt64
+		"-$92233720368547758.00",
+	}
+
+	for _, input := range inputs {
+		if _, err := ParseMoney(input); !errors.Is(err, ErrInvalidMoney) {
+			t.Errorf("ParseMoney(%q) error = %v; expected ErrInvalidMoney", input, err)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package main
+
+// LargeStruct is big enough (1024 ints = 8 KB on 64-bit systems) that
+// copying it is noticeable. It's used to measure what a value receiver
+// costs compared to a pointer receiver; see receivers_test.go.
+type LargeStruct struct {
+	data [1024]int
+}
+
+// FirstByValue returns the first element using a value receiver. The
+// method does almost nothing, but every call first copies the whole
+// struct (all 8 KB).
+//
+//go:noinline
+func (ls LargeStruct) FirstByValue() int {
+	return ls.data[0]
+}
+
+// FirstByPointer does the same with a pointer receiver, so only the
+// address (8 bytes) is passed
+//
+//go:noinline
+func (ls *LargeStruct) FirstByPointer() int {
+	return ls.data[0]
+}
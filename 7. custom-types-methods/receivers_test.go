@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+// The go:noinline directives on the methods stop the compiler from
+// inlining them, which could let it skip the copy and hide the difference.
+// Storing results in sink stops it from removing the calls altogether.
+var sink int
+
+func TestLargeStructReceivers(t *testing.T) {
+	var ls LargeStruct
+	ls.data[0] = 42
+
+	if got := ls.FirstByValue(); got != 42 {
+		t.Errorf("FirstByValue() = %d; expected 42", got)
+	}
+	if got := ls.FirstByPointer(); got != 42 {
+		t.Errorf("FirstByPointer() = %d; expected 42", got)
+	}
+}
+
+// Run with: go test -bench=Receiver -benchmem
+func BenchmarkValueReceiver(b *testing.B) {
+	var ls LargeStruct
+	for i := 0; i < b.N; i++ {
+		sink = ls.FirstByValue() // Copies 8 KB per call
+	}
+}
+
+func BenchmarkPointerReceiver(b *testing.B) {
+	var ls LargeStruct
+	for i := 0; i < b.N; i++ {
+		sink = ls.FirstByPointer() // Go passes &ls automatically
+	}
+}
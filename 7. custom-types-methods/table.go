@@ -0,0 +1,63 @@
+package main
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatTable lays out headers and rows as aligned text columns, e.g.
+//
+//	Name   Age
+//	-----  ---
+//	Alice  25
+//
+// Every column is as wide as its longest cell. Rows with fewer cells than
+// there are headers are padded with empty cells.
+func FormatTable(headers []string, rows [][]string) string {
+	numCols := len(headers)
+	for _, row := range rows {
+		numCols = max(numCols, len(row))
+	}
+
+	// Find the width of each column
+	widths := make([]int, numCols)
+	for i, header := range headers {
+		widths[i] = utf8.RuneCountInString(header)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	var sb strings.Builder
+	writeRow := func(cells []string) {
+		var line strings.Builder
+		for i := 0; i < numCols; i++ {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			if i > 0 {
+				line.WriteString("  ")
+			}
+			line.WriteString(cell)
+			line.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+		}
+		// Padding after the last column is invisible, so drop it
+		sb.WriteString(strings.TrimRight(line.String(), " "))
+		sb.WriteString("\n")
+	}
+
+	writeRow(headers)
+	separators := make([]string, numCols)
+	for i, width := range widths {
+		separators[i] = strings.Repeat("-", width)
+	}
+	writeRow(separators)
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	return sb.String()
+}
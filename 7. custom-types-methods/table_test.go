@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatTableAlignment(t *testing.T) {
+	headers := []string{"Name", "Age", "Email"}
+	rows := [][]string{
+		{"Alice", "25", "alice@example.com"},
+		{"Bob", "130", "bob@example.com"},
+	}
+
+	expected := "" +
+		"Name   Age  Email\n" +
+		"-----  ---  -----------------\n" +
+		"Alice  25   alice@example.com\n" +
+		"Bob    130  bob@example.com\n"
+
+	if got := FormatTable(headers, rows); got != expected {
+		t.Errorf("FormatTable() =\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestFormatTablePadsShortRows(t *testing.T) {
+	headers := []string{"Name", "Age", "City"}
+	rows := [][]string{
+		{"Alice", "25", "Paris"},
+		{"Bob"}, // Missing age and city
+		{"", "40", "Rome"},
+	}
+
+	lines := strings.Split(strings.TrimSuffix(FormatTable(headers, rows), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d lines; expected 5 (header, separator, 3 rows)", len(lines))
+	}
+
+	if lines[3] != "Bob" {
+		t.Errorf("short row = %q; expected %q", lines[3], "Bob")
+	}
+	// The empty first cell must still take up the full column width
+	if lines[4] != "       40   Rome" {
+		t.Errorf("row with empty cell = %q; expected %q", lines[4], "       40   Rome")
+	}
+	// The "City" column starts at the same offset in every full row
+	for i, city := range map[int]string{0: "City", 2: "Paris", 4: "Rome"} {
+		if lines[i][12:] != city {
+			t.Errorf("line %d = %q; expected %q to start at column 12", i, lines[i], city)
+		}
+	}
+}
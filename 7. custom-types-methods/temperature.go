@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrBelowAbsoluteZero is returned when a temperature is colder than 0 Kelvin
+var ErrBelowAbsoluteZero = errors.New("temperature below absolute zero")
+
+// absoluteZeroCelsius is 0 Kelvin expressed in Celsius
+const absoluteZeroCelsius = -273.15
+
+// Temperature stores a temperature internally in Kelvin
+// The field is unexported so every Temperature is created through a
+// constructor that rejects impossible (sub-absolute-zero) values
+type Temperature struct {
+	kelvin float64
+}
+
+// FromKelvin creates a Temperature from a Kelvin value
+func FromKelvin(k float64) (Temperature, error) {
+	if k < 0 {
+		return Temperature{}, fmt.Errorf("%w: %.2fK", ErrBelowAbsoluteZero, k)
+	}
+	return Temperature{kelvin: k}, nil
+}
+
+// FromCelsius creates a Temperature from a Celsius value
+func FromCelsius(c float64) (Temperature, error) {
+	if c < absoluteZeroCelsius {
+		return Temperature{}, fmt.Errorf("%w: %.2f°C", ErrBelowAbsoluteZero, c)
+	}
+	return Temperature{kelvin: c - absoluteZeroCelsius}, nil
+}
+
+// FromFahrenheit creates a Temperature from a Fahrenheit value
+func FromFahrenheit(f float64) (Temperature, error) {
+	c := (f - 32) * 5 / 9
+	if c < absoluteZeroCelsius {
+		return Temperature{}, fmt.Errorf("%w: %.2f°F", ErrBelowAbsoluteZero, f)
+	}
+	return Temperature{kelvin: c - absoluteZeroCelsius}, nil
+}
+
+// Kelvin returns the temperature in Kelvin
+func (t Temperature) Kelvin() float64 {
+	return t.kelvin
+}
+
+// Celsius returns the temperature in degrees Celsius
+func (t Temperature) Celsius() float64 {
+	return t.kelvin + absoluteZeroCelsius
+}
+
+// Fahrenheit returns the temperature in degrees Fahrenheit
+func (t Temperature) Fahrenheit() float64 {
+	return t.Celsius()*9/5 + 32
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// approxEqual compares floats with a small tolerance to absorb rounding
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestTemperatureConversions(t *testing.T) {
+	tests := []struct {
+		name       string
+		celsius    float64
+		fahrenheit float64
+		kelvin     float64
+	}{
+		{"freezing water", 0, 32, 273.15},
+		{"boiling water", 100, 212, 373.15},
+		{"absolute zero", -273.15, -459.67, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromC, err := FromCelsius(tt.celsius)
+			if err != nil {
+				t.Fatalf("FromCelsius(%v) returned error: %v", tt.celsius, err)
+			}
+			if !approxEqual(fromC.Fahrenheit(), tt.fahrenheit) {
+				t.Errorf("Fahrenheit() = %v; expected %v", fromC.Fahrenheit(), tt.fahrenheit)
+			}
+			if !approxEqual(fromC.Kelvin(), tt.kelvin) {
+				t.Errorf("Kelvin() = %v; expected %v", fromC.Kelvin(), tt.kelvin)
+			}
+
+			fromF, err := FromFahrenheit(tt.fahrenheit)
+			if err != nil {
+				t.Fatalf("FromFahrenheit(%v) returned error: %v", tt.fahrenheit, err)
+			}
+			if !approxEqual(fromF.Celsius(), tt.celsius) {
+				t.Errorf("Celsius() = %v; expected %v", fromF.Celsius(), tt.celsius)
+			}
+		})
+	}
+}
+
+func TestTemperatureBelowAbsoluteZero(t *testing.T) {
+	if _, err := FromCelsius(-300); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("FromCelsius(-300) error = %v; expected ErrBelowAbsoluteZero", err)
+	}
+	if _, err := FromFahrenheit(-500); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("FromFahrenheit(-500) error = %v; expected ErrBelowAbsoluteZero", err)
+	}
+	if _, err := FromKelvin(-1); !errors.Is(err, ErrBelowAbsoluteZero) {
+		t.Errorf("FromKelvin(-1) error = %v; expected ErrBelowAbsoluteZero", err)
+	}
+}
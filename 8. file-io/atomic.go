@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic writes data to path so that readers (and a crash) see
+// either the old file or the complete new one, never a half-written file.
+// It writes to a temporary file in the same directory, flushes it to disk
+// with Sync, then renames it over path. A rename within one directory
+// replaces the target in a single step.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	// The temp file must live in the same directory: rename can't move a
+	// file across filesystems in one step
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+
+	// Until the rename succeeds, any failure must remove the temp file
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			tmp.Close()
+			os.Remove(tmpName)
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		return err
+	}
+	// CreateTemp always uses 0600, so apply the requested permissions
+	if err := tmp.Chmod(perm); err != nil {
+		return err
+	}
+	// Sync makes sure the data is on disk before the rename makes it visible
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		return err
+	}
+
+	succeeded = true
+	return nil
+}
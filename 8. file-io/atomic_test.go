@@ -0,0 +1,77 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.txt")
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFileAtomic(path, []byte("new contents"), 0600); err != nil {
+		t.Fatalf("WriteFileAtomic returned error: %v", err)
+	}
+
+	got, _ := os.ReadFile(path)
+	if string(got) != "new contents" {
+		t.Errorf("file = %q; expected %q", got, "new contents")
+	}
+	info, _ := os.Stat(path)
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("mode = %v; expected %v", info.Mode().Perm(), os.FileMode(0600))
+	}
+	assertOnlyEntries(t, dir, "config.txt")
+}
+
+func TestWriteFileAtomicFailureLeavesTargetUntouched(t *testing.T) {
+	dir := t.TempDir()
+	// A non-empty directory can't be replaced by a file, so the final
+	// rename fails after the temp file has been written
+	target := filepath.Join(dir, "target")
+	if err := os.MkdirAll(filepath.Join(target, "keep"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFileAtomic(target, []byte("data"), 0644); err == nil {
+		t.Fatal("WriteFileAtomic over a directory returned nil error; expected an error")
+	}
+
+	if info, err := os.Stat(target); err != nil || !info.IsDir() {
+		t.Errorf("target changed: info=%v err=%v; expected the directory untouched", info, err)
+	}
+	assertOnlyEntries(t, dir, "target") // The temp file was removed
+}
+
+func TestWriteFileAtomicMissingDirectory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "no-such-dir", "file.txt")
+
+	if err := WriteFileAtomic(path, []byte("data"), 0644); err == nil {
+		t.Error("WriteFileAtomic into a missing directory returned nil error; expected an error")
+	}
+}
+
+// assertOnlyEntries fails the test unless dir contains exactly the named entries
+func assertOnlyEntries(t *testing.T, dir string, expected ...string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	if len(names) != len(expected) {
+		t.Fatalf("directory contains %v; expected %v", names, expected)
+	}
+	for i := range names {
+		if names[i] != expected[i] {
+			t.Fatalf("directory contains %v; expected %v", names, expected)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CountLines returns the number of lines in the file at path. A last line
+// without a trailing newline still counts; an empty file has 0 lines.
+func CountLines(path string) (int, error) {
+	return countTokens(path, bufio.ScanLines)
+}
+
+// CountWords returns the number of whitespace-separated words in the file
+// at path
+func CountWords(path string) (int, error) {
+	return countTokens(path, splitWords)
+}
+
+// countTokens counts how many tokens a Scanner using split finds in the file
+func countTokens(path string, split bufio.SplitFunc) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(split)
+	count := 0
+	for scanner.Scan() {
+		count++
+	}
+	return count, scanner.Err()
+}
+
+// splitWords is a bufio.SplitFunc that returns one word per token.
+// The Scanner calls it with the unread data; it returns how many bytes
+// to skip past (advance) and the word found, or asks for more data by
+// returning 0, nil, nil.
+func splitWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	// Skip leading whitespace
+	start := 0
+	for start < len(data) {
+		r, width := utf8.DecodeRune(data[start:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		start += width
+	}
+
+	// The word ends at the next whitespace
+	for i := start; i < len(data); {
+		r, width := utf8.DecodeRune(data[i:])
+		if unicode.IsSpace(r) {
+			return i + width, data[start:i], nil
+		}
+		i += width
+	}
+
+	// No whitespace after the word yet: at EOF it's the last word,
+	// otherwise wait for more data
+	if atEOF && start < len(data) {
+		return len(data), data[start:], nil
+	}
+	return start, nil, nil
+}
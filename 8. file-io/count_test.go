@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCountLinesAndWords(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedLines int
+		expectedWords int
+	}{
+		{"empty file", "", 0, 0},
+		{"one line", "hello world\n", 1, 2},
+		{"multi-line", "the quick\nbrown fox\njumps\n", 3, 5},
+		{"no trailing newline", "first line\nsecond line", 2, 4},
+		{"blank lines", "a\n\n\nb\n", 4, 2},
+		{"only whitespace", "  \t \n\n", 2, 0},
+		{"mixed whitespace", "one\ttwo  three\r\nfour", 2, 4},
+		{"unicode", "héllo wörld\u00a0naïve\n", 1, 3}, // \u00a0 is a no-break space
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "input.txt")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			lines, err := CountLines(path)
+			if err != nil || lines != tt.expectedLines {
+				t.Errorf("CountLines = %d, %v; expected %d", lines, err, tt.expectedLines)
+			}
+			words, err := CountWords(path)
+			if err != nil || words != tt.expectedWords {
+				t.Errorf("CountWords = %d, %v; expected %d", words, err, tt.expectedWords)
+			}
+		})
+	}
+}
+
+func TestCountWordsLargeFile(t *testing.T) {
+	// Bigger than the Scanner's first read, so words get split across reads
+	path := filepath.Join(t.TempDir(), "large.txt")
+	os.WriteFile(path, []byte(strings.Repeat("lorem ipsum dolor\n", 10000)), 0644)
+
+	words, err := CountWords(path)
+	if err != nil || words != 30000 {
+		t.Errorf("CountWords = %d, %v; expected 30000", words, err)
+	}
+	lines, err := CountLines(path)
+	if err != nil || lines != 10000 {
+		t.Errorf("CountLines = %d, %v; expected 10000", lines, err)
+	}
+}
+
+func TestCountMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.txt")
+
+	if _, err := CountLines(path); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("CountLines error = %v; expected fs.ErrNotExist", err)
+	}
+	if _, err := CountWords(path); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("CountWords error = %v; expected fs.ErrNotExist", err)
+	}
+}
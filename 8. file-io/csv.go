@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/csv"
+	"os"
+)
+
+// WriteCSV writes records to path as comma-separated values. Fields that
+// contain commas, quotes or newlines are quoted by encoding/csv.
+func WriteCSV(path string, records [][]string) error {
+	return WriteCSVComma(path, records, ',')
+}
+
+// ReadCSV reads every record from the comma-separated file at path
+func ReadCSV(path string) ([][]string, error) {
+	return ReadCSVComma(path, ',')
+}
+
+// WriteCSVComma is WriteCSV with a different field separator, e.g. ';'
+// or '\t'
+func WriteCSVComma(path string, records [][]string, comma rune) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	writer.Comma = comma
+	// WriteAll flushes for us and reports any write error
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// ReadCSVComma is ReadCSV with a different field separator
+func ReadCSVComma(path string, comma rune) ([][]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comma = comma
+	// By default every record must have as many fields as the first one
+	return reader.ReadAll()
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	records := [][]string{
+		{"name", "address", "note"},
+		{"Alice", "1 Main St, Springfield", `says "hi"`},
+		{"Bob", "PO Box 7", "line one\nline two"},
+		{"", "empty name", ""},
+	}
+
+	tests := []struct {
+		name  string
+		comma rune
+	}{
+		{"comma", ','},
+		{"semicolon", ';'},
+		{"tab", '\t'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "people.csv")
+
+			if err := WriteCSVComma(path, records, tt.comma); err != nil {
+				t.Fatalf("WriteCSVComma returned error: %v", err)
+			}
+			got, err := ReadCSVComma(path, tt.comma)
+			if err != nil {
+				t.Fatalf("ReadCSVComma returned error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, records) {
+				t.Errorf("ReadCSVComma = %q; expected %q", got, records)
+			}
+		})
+	}
+}
+
+func TestWriteCSVQuotesFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quoted.csv")
+
+	if err := WriteCSV(path, [][]string{{"a,b", `say "hi"`, "plain"}}); err != nil {
+		t.Fatalf("WriteCSV returned error: %v", err)
+	}
+
+	got, _ := os.ReadFile(path)
+	expected := `"a,b","say ""hi""",plain` + "\n"
+	if string(got) != expected {
+		t.Errorf("file = %q; expected %q", got, expected)
+	}
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := ReadCSV(filepath.Join(dir, "missing.csv"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadCSV error = %v; expected os.ErrNotExist", err)
+	}
+
+	ragged := filepath.Join(dir, "ragged.csv")
+	os.WriteFile(ragged, []byte("a,b\nc\n"), 0644)
+	_, err = ReadCSV(ragged)
+	if !errors.Is(err, csv.ErrFieldCount) {
+		t.Errorf("ReadCSV error = %v; expected csv.ErrFieldCount", err)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+)
+
+// ErrDestExists is returned by CopyFile when it may not overwrite dst
+var ErrDestExists = errors.New("destination already exists")
+
+// ErrSameFile is returned by CopyFile when src and dst are the same file
+var ErrSameFile = errors.New("source and destination are the same file")
+
+// These helpers return errors instead of printing them, so the caller
+// decides what to do (print, retry, give up) and tests can check them.
+// The errors from the os package already name the file, e.g.
+// "open missing.txt: no such file or directory".
+
+// WriteFile writes data to path in one go, creating or truncating it
+func WriteFile(path string, data []byte) error {
+	return os.WriteFile(path, data, 0644)
+}
+
+// ReadFile reads the whole file at path into memory
+func ReadFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// WriteLines writes each line followed by "\n" through a buffered writer,
+// which collects small writes into fewer, bigger ones
+func WriteLines(path string, lines []string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	for _, line := range lines {
+		if _, err := writer.WriteString(line + "\n"); err != nil {
+			return err
+		}
+	}
+	// Nothing reaches the file until Flush; forgetting it loses the data
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Close() // Close can fail too (e.g. disk full), so check it
+}
+
+// ReadBuffered reads the whole file at path through a buffered reader
+func ReadBuffered(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(bufio.NewReader(file))
+}
+
+// AppendToFile adds text to the end of an existing file
+func AppendToFile(path, text string) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(text); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// ReadLines returns the lines of the file at path, without their "\n"
+func ReadLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err() // nil unless reading failed part-way
+}
+
+// CopyFile copies src to dst and returns the number of bytes copied.
+// If dst exists it is replaced only when overwrite is true; otherwise
+// CopyFile returns ErrDestExists. The copy gets the same permission bits
+// as src. Copying a file onto itself (even through another path or a hard
+// link) returns ErrSameFile.
+func CopyFile(src, dst string, overwrite bool) (int64, error) {
+	source, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer source.Close()
+
+	info, err := source.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	// Opening dst with O_TRUNC would empty src before a byte was copied
+	if dstInfo, err := os.Stat(dst); err == nil && os.SameFile(info, dstInfo) {
+		return 0, fmt.Errorf("copying %s to %s: %w", src, dst, ErrSameFile)
+	}
+
+	// O_EXCL makes the "does it exist?" check and the create one step,
+	// so another program can't create dst in between
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	dest, err := os.OpenFile(dst, flags, info.Mode().Perm())
+	if errors.Is(err, fs.ErrExist) {
+		return 0, fmt.Errorf("copying to %s: %w", dst, ErrDestExists)
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer dest.Close()
+
+	// The mode passed to OpenFile is only used for new files (and the umask
+	// can strip bits), so set it explicitly
+	if err := dest.Chmod(info.Mode().Perm()); err != nil {
+		return 0, err
+	}
+
+	writer := bufio.NewWriter(dest)
+	written, err := io.Copy(writer, source)
+	if err != nil {
+		return written, err
+	}
+	if err := writer.Flush(); err != nil {
+		return written, err
+	}
+	return written, dest.Close()
+}
+
+// FileExists reports whether something exists at path. It returns
+// (false, nil) only when the path really doesn't exist; any other Stat
+// failure, like permission denied, is returned as an error because then we
+// can't tell whether the file is there.
+func FileExists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestWriteFileReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hello.txt")
+	data := []byte("Hello, File I/O!\n")
+
+	if err := WriteFile(path, data); err != nil {
+		t.Fatalf("WriteFile returned error: %v", err)
+	}
+
+	got, err := ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile returned error: %v", err)
+	}
+	if string(got) != string(data) {
+		t.Errorf("ReadFile = %q; expected %q", got, data)
+	}
+}
+
+func TestReadFileMissing(t *testing.T) {
+	_, err := ReadFile(filepath.Join(t.TempDir(), "missing.txt"))
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFile error = %v; expected fs.ErrNotExist", err)
+	}
+}
+
+func TestWriteFileIntoMissingDirectory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "no-such-dir", "file.txt")
+
+	if err := WriteFile(path, []byte("data")); err == nil {
+		t.Error("expected an error writing into a directory that doesn't exist")
+	}
+}
+
+func TestWriteLinesReadLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lines.txt")
+	lines := []string{"first", "second", "third"}
+
+	if err := WriteLines(path, lines); err != nil {
+		t.Fatalf("WriteLines returned error: %v", err)
+	}
+
+	got, err := ReadLines(path)
+	if err != nil {
+		t.Fatalf("ReadLines returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, lines) {
+		t.Errorf("ReadLines = %v; expected %v", got, lines)
+	}
+
+	buffered, err := ReadBuffered(path)
+	if err != nil {
+		t.Fatalf("ReadBuffered returned error: %v", err)
+	}
+	if string(buffered) != "first\nsecond\nthird\n" {
+		t.Errorf("ReadBuffered = %q", buffered)
+	}
+}
+
+func TestAppendToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.txt")
+	if err := WriteFile(path, []byte("one\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := AppendToFile(path, "two\n"); err != nil {
+		t.Fatalf("AppendToFile returned error: %v", err)
+	}
+
+	got, _ := ReadFile(path)
+	if string(got) != "one\ntwo\n" {
+		t.Errorf("file contents = %q; expected %q", got, "one\ntwo\n")
+	}
+}
+
+func TestMissingFileErrors(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.txt")
+
+	if err := AppendToFile(missing, "text"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("AppendToFile error = %v; expected fs.ErrNotExist", err)
+	}
+	if _, err := ReadLines(missing); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadLines error = %v; expected fs.ErrNotExist", err)
+	}
+	if _, err := ReadBuffered(missing); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadBuffered error = %v; expected fs.ErrNotExist", err)
+	}
+}
+
+func TestCopyFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.sh")
+	if err := os.WriteFile(src, []byte("#!/bin/sh\necho hi\n"), 0750); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		existing    bool // Create dst before copying
+		overwrite   bool
+		expectedErr error
+	}{
+		{"new destination", false, false, nil},
+		{"overwrite allowed", true, true, nil},
+		{"overwrite refused", true, false, ErrDestExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := filepath.Join(t.TempDir(), "dst.sh")
+			if tt.existing {
+				os.WriteFile(dst, []byte("old contents"), 0600)
+			}
+
+			n, err := CopyFile(src, dst, tt.overwrite)
+
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("CopyFile error = %v; expected %v", err, tt.expectedErr)
+			}
+			got, _ := os.ReadFile(dst)
+			if tt.expectedErr != nil {
+				if string(got) != "old contents" {
+					t.Errorf("destination changed to %q; expected it untouched", got)
+				}
+				return
+			}
+
+			if n != 18 || string(got) != "#!/bin/sh\necho hi\n" {
+				t.Errorf("copied %d bytes %q; expected the 18-byte source", n, got)
+			}
+			info, _ := os.Stat(dst)
+			if info.Mode().Perm() != 0750 {
+				t.Errorf("mode = %v; expected %v", info.Mode().Perm(), fs.FileMode(0750))
+			}
+		})
+	}
+}
+
+func TestCopyFileMissingSource(t *testing.T) {
+	dir := t.TempDir()
+	dst := filepath.Join(dir, "dst.txt")
+
+	_, err := CopyFile(filepath.Join(dir, "missing.txt"), dst, true)
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("CopyFile error = %v; expected fs.ErrNotExist", err)
+	}
+	if _, statErr := os.Stat(dst); !errors.Is(statErr, fs.ErrNotExist) {
+		t.Error("destination was created even though the source is missing")
+	}
+}
+
+func TestCopyFileOntoItself(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.txt")
+	if err := os.WriteFile(src, []byte("keep me"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "link.txt")
+	if err := os.Link(src, link); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dst := range []string{src, filepath.Join(dir, ".", "src.txt"), link} {
+		for _, overwrite := range []bool{true, false} {
+			if _, err := CopyFile(src, dst, overwrite); !errors.Is(err, ErrSameFile) {
+				t.Errorf("CopyFile(%s, %s, %v) error = %v; expected ErrSameFile", src, dst, overwrite, err)
+			}
+		}
+	}
+	if got, _ := os.ReadFile(src); string(got) != "keep me" {
+		t.Errorf("source changed to %q; expected it untouched", got)
+	}
+}
+
+func TestFileExists(t *testing.T) {
+	dir := t.TempDir()
+	present := filepath.Join(dir, "present.txt")
+	os.WriteFile(present, []byte("hi"), 0644)
+
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{"present file", present, true},
+		{"directory", dir, true},
+		{"absent file", filepath.Join(dir, "absent.txt"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exists, err := FileExists(tt.path)
+			if err != nil || exists != tt.expected {
+				t.Errorf("FileExists = %v, %v; expected %v, nil", exists, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFileExistsPermissionDenied(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root ignores directory permissions")
+	}
+	locked := filepath.Join(t.TempDir(), "locked")
+	os.Mkdir(locked, 0755)
+	os.WriteFile(filepath.Join(locked, "secret.txt"), []byte("hi"), 0644)
+	os.Chmod(locked, 0) // No permission to look inside
+	t.Cleanup(func() { os.Chmod(locked, 0755) })
+
+	exists, err := FileExists(filepath.Join(locked, "secret.txt"))
+
+	if exists || !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("FileExists = %v, %v; expected false, fs.ErrPermission", exists, err)
+	}
+}
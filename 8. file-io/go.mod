@@ -0,0 +1,3 @@
+module file-io
+
+go 1.23.0
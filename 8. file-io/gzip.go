@@ -0,0 +1,68 @@
+package main
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// GzipFile compresses src into dst. The data is streamed through the
+// compressor with io.Copy, so even a huge file never sits in memory.
+func GzipFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	// Writes to zw are compressed and passed on to out
+	zw := gzip.NewWriter(out)
+	zw.Name = filepath.Base(src) // Stored in the gzip header, like the gzip command does
+	if _, err := io.Copy(zw, in); err != nil {
+		return err
+	}
+	// Close writes the end of the compressed stream - without it the
+	// file is truncated
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// GunzipFile decompresses the gzip file src into dst. It returns
+// gzip.ErrHeader if src isn't gzip data.
+func GunzipFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	// NewReader reads the header straight away, so bad input fails here,
+	// before dst is created
+	zr, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	// Reads from zr come out decompressed; a corrupt stream or a bad
+	// checksum shows up as an error from Copy
+	if _, err := io.Copy(out, zr); err != nil {
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGzipRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	original := []byte(strings.Repeat("The quick brown fox jumps over the lazy dog.\n", 1000))
+	src := filepath.Join(dir, "input.txt")
+	compressed := filepath.Join(dir, "input.txt.gz")
+	restored := filepath.Join(dir, "restored.txt")
+	os.WriteFile(src, original, 0644)
+
+	if err := GzipFile(src, compressed); err != nil {
+		t.Fatalf("GzipFile returned error: %v", err)
+	}
+	if err := GunzipFile(compressed, restored); err != nil {
+		t.Fatalf("GunzipFile returned error: %v", err)
+	}
+
+	got, _ := os.ReadFile(restored)
+	if !bytes.Equal(got, original) {
+		t.Errorf("restored file differs from the original (%d bytes vs %d)", len(got), len(original))
+	}
+	info, _ := os.Stat(compressed)
+	if info.Size() >= int64(len(original)) {
+		t.Errorf("compressed size = %d; expected less than %d", info.Size(), len(original))
+	}
+}
+
+func TestGunzipFileNotGzip(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "plain.txt")
+	dst := filepath.Join(dir, "out.txt")
+	os.WriteFile(src, []byte("this is not gzip data"), 0644)
+
+	err := GunzipFile(src, dst)
+
+	if !errors.Is(err, gzip.ErrHeader) {
+		t.Errorf("GunzipFile error = %v; expected gzip.ErrHeader", err)
+	}
+	if _, statErr := os.Stat(dst); !errors.Is(statErr, fs.ErrNotExist) {
+		t.Error("GunzipFile created the destination for invalid input")
+	}
+}
+
+func TestGzipFileMissingSource(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := GzipFile(filepath.Join(dir, "missing.txt"), filepath.Join(dir, "out.gz")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("GzipFile error = %v; expected fs.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// User is a small record used by the JSON examples
+type User struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Age   int    `json:"age"`
+}
+
+// SaveJSON writes v to path as indented JSON. The file is written with
+// WriteFileAtomic, so a crash never leaves half a JSON document behind.
+func SaveJSON[T any](path string, v T) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	return WriteFileAtomic(path, data, 0644)
+}
+
+// LoadJSON reads the JSON file at path into a new T. A file that isn't
+// valid JSON gives an error naming the file, wrapping the decoder's error.
+func LoadJSON[T any](path string) (T, error) {
+	var v T
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return v, err
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return v, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	return v, nil
+}
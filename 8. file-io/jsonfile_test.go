@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.json")
+	users := []User{
+		{Name: "Alice", Email: "alice@example.com", Age: 30},
+		{Name: "Bob", Email: "bob@example.com", Age: 25},
+	}
+
+	if err := SaveJSON(path, users); err != nil {
+		t.Fatalf("SaveJSON returned error: %v", err)
+	}
+	got, err := LoadJSON[[]User](path)
+	if err != nil {
+		t.Fatalf("LoadJSON returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, users) {
+		t.Errorf("LoadJSON = %+v; expected %+v", got, users)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "\n  {\n    \"name\": \"Alice\"") {
+		t.Errorf("file is not indented:\n%s", data)
+	}
+}
+
+func TestLoadJSONErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadJSON[[]User](filepath.Join(dir, "missing.json"))
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("LoadJSON error = %v; expected fs.ErrNotExist", err)
+	}
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	os.WriteFile(corrupt, []byte(`[{"name": "Alice", "age": 30`), 0644) // Cut off
+	_, err = LoadJSON[[]User](corrupt)
+
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("LoadJSON error = %v; expected a wrapped *json.SyntaxError", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), corrupt) {
+		t.Errorf("LoadJSON error = %v; expected it to name %s", err, corrupt)
+	}
+}
@@ -1,10 +1,8 @@
 package main
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
-	"io"
-	"os"
 )
 
 func main() {
@@ -34,14 +32,31 @@ func main() {
 
 	// Example 8: Checking if file exists
 	checkFileExists()
+
+	// Example 9: Atomic writes
+	writeAtomically()
+
+	// Example 10: CSV files
+	csvFiles()
+
+	// Example 11: JSON files
+	jsonFiles()
+
+	// Example 12: Finding files in a directory tree
+	findFiles()
+
+	// Example 13: Reading the last lines of a file
+	tailFile()
+
+	// Example 14: Compressing files
+	compressFile()
 }
 
 // Example 1: Writing to a file (simple)
 func writeSimpleFile() {
 	fmt.Println("1. Writing to a file (simple):")
 	data := []byte("Hello, File I/O!\nThis is a test file.\n")
-	err := os.WriteFile("output.txt", data, 0644)
-	if err != nil {
+	if err := WriteFile("output.txt", data); err != nil {
 		fmt.Println("Error writing file:", err)
 		return
 	}
@@ -52,7 +67,7 @@ func writeSimpleFile() {
 // Example 2: Reading from a file (simple)
 func readSimpleFile() {
 	fmt.Println("2. Reading from a file (simple):")
-	data, err := os.ReadFile("output.txt")
+	data, err := ReadFile("output.txt")
 	if err != nil {
 		fmt.Println("Error reading file:", err)
 		return
@@ -64,18 +79,15 @@ func readSimpleFile() {
 // Example 3: Writing with buffered writer
 func writeBufferedFile() {
 	fmt.Println("3. Writing with buffered writer:")
-	file, err := os.Create("buffered.txt")
-	if err != nil {
-		fmt.Println("Error creating file:", err)
+	lines := []string{
+		"Line 1: Using buffered writer",
+		"Line 2: More efficient for multiple writes",
+		"Line 3: Don't forget to flush!",
+	}
+	if err := WriteLines("buffered.txt", lines); err != nil {
+		fmt.Println("Error writing file:", err)
 		return
 	}
-	defer file.Close()
-
-	writer := bufio.NewWriter(file)
-	writer.WriteString("Line 1: Using buffered writer\n")
-	writer.WriteString("Line 2: More efficient for multiple writes\n")
-	writer.WriteString("Line 3: Don't forget to flush!\n")
-	writer.Flush()
 	fmt.Println("✓ Successfully wrote buffered.txt")
 	fmt.Println()
 }
@@ -83,15 +95,7 @@ func writeBufferedFile() {
 // Example 4: Reading with buffered reader
 func readBufferedFile() {
 	fmt.Println("4. Reading with buffered reader:")
-	file, err := os.Open("buffered.txt")
-	if err != nil {
-		fmt.Println("Error opening file:", err)
-		return
-	}
-	defer file.Close()
-
-	reader := bufio.NewReader(file)
-	content, err := io.ReadAll(reader)
+	content, err := ReadBuffered("buffered.txt")
 	if err != nil {
 		fmt.Println("Error reading file:", err)
 		return
@@ -103,15 +107,7 @@ func readBufferedFile() {
 // Example 5: Appending to a file
 func appendToFile() {
 	fmt.Println("5. Appending to a file:")
-	file, err := os.OpenFile("output.txt", os.O_APPEND|os.O_WRONLY, 0644)
-	if err != nil {
-		fmt.Println("Error opening file for append:", err)
-		return
-	}
-	defer file.Close()
-
-	_, err = file.WriteString("This line was appended!\n")
-	if err != nil {
+	if err := AppendToFile("output.txt", "This line was appended!\n"); err != nil {
 		fmt.Println("Error appending to file:", err)
 		return
 	}
@@ -122,48 +118,44 @@ func appendToFile() {
 // Example 6: Reading file line by line
 func readLineByLine() {
 	fmt.Println("6. Reading file line by line:")
-	file, err := os.Open("output.txt")
+	lines, err := ReadLines("output.txt")
 	if err != nil {
-		fmt.Println("Error opening file:", err)
-		return
+		fmt.Println("Error reading file:", err)
+	}
+	for i, line := range lines { // Print what we got, even after an error
+		fmt.Printf("Line %d: %s\n", i+1, line)
 	}
-	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
-	lineNum := 1
-	for scanner.Scan() {
-		fmt.Printf("Line %d: %s\n", lineNum, scanner.Text())
-		lineNum++
+	// CountLines and CountWords scan the file without keeping the lines
+	lineCount, err := CountLines("output.txt")
+	if err != nil {
+		fmt.Println("Error counting lines:", err)
+		return
 	}
-	if err := scanner.Err(); err != nil {
-		fmt.Println("Error reading file:", err)
+	wordCount, err := CountWords("output.txt")
+	if err != nil {
+		fmt.Println("Error counting words:", err)
+		return
 	}
+	fmt.Printf("output.txt has %d lines and %d words\n", lineCount, wordCount)
 	fmt.Println()
 }
 
 // Example 7: Copying files
 func copyFile() {
 	fmt.Println("7. Copying files:")
-	sourceFile, err := os.Open("output.txt")
+	bytesWritten, err := CopyFile("output.txt", "output_copy.txt", true)
 	if err != nil {
-		fmt.Println("Error opening source file:", err)
-		return
-	}
-	defer sourceFile.Close()
-
-	destFile, err := os.Create("output_copy.txt")
-	if err != nil {
-		fmt.Println("Error creating destination file:", err)
+		fmt.Println("Error copying file:", err)
 		return
 	}
-	defer destFile.Close()
+	fmt.Printf("✓ Copied %d bytes to output_copy.txt\n", bytesWritten)
 
-	bytesWritten, err := io.Copy(destFile, sourceFile)
-	if err != nil {
-		fmt.Println("Error copying file:", err)
-		return
+	// Without overwrite, an existing destination is left alone
+	if _, err := CopyFile("output.txt", "output_copy.txt", false); errors.Is(err, ErrDestExists) {
+		fmt.Println("✓ Refused to overwrite output_copy.txt:", err)
 	}
-	fmt.Printf("✓ Copied %d bytes to output_copy.txt\n\n", bytesWritten)
+	fmt.Println()
 }
 
 // Example 8: Checking if file exists
@@ -171,14 +163,118 @@ func checkFileExists() {
 	fmt.Println("8. Checking if file exists:")
 	files := []string{"output.txt", "nonexistent.txt"}
 	for _, filename := range files {
-		if _, err := os.Stat(filename); err == nil {
+		exists, err := FileExists(filename)
+		if err != nil {
+			// e.g. permission denied - we can't say either way
+			fmt.Printf("? Error checking %s: %v\n", filename, err)
+		} else if exists {
 			fmt.Printf("✓ %s exists\n", filename)
-		} else if os.IsNotExist(err) {
-			fmt.Printf("✗ %s does not exist\n", filename)
 		} else {
-			fmt.Printf("? Error checking %s: %v\n", filename, err)
+			fmt.Printf("✗ %s does not exist\n", filename)
 		}
 	}
+	fmt.Println()
 }
 
+// Example 9: Atomic writes
+func writeAtomically() {
+	fmt.Println("9. Atomic writes:")
+	settings := []byte("theme=dark\nfont_size=14\n")
+	if err := WriteFileAtomic("settings.txt", settings, 0644); err != nil {
+		fmt.Println("Error writing file:", err)
+		return
+	}
+	fmt.Println("✓ Wrote settings.txt atomically (temp file + rename)")
+	fmt.Println()
+}
+
+// Example 10: CSV files
+func csvFiles() {
+	fmt.Println("10. CSV files:")
+	records := [][]string{
+		{"name", "city"},
+		{"Alice", "Paris, France"}, // The comma inside gets quoted
+		{"Bob", "Berlin"},
+	}
+	if err := WriteCSV("people.csv", records); err != nil {
+		fmt.Println("Error writing CSV:", err)
+		return
+	}
+
+	rows, err := ReadCSV("people.csv")
+	if err != nil {
+		fmt.Println("Error reading CSV:", err)
+		return
+	}
+	for _, row := range rows[1:] { // Skip the header
+		fmt.Printf("  %s lives in %s\n", row[0], row[1])
+	}
+	fmt.Println()
+}
+
+// Example 11: JSON files
+func jsonFiles() {
+	fmt.Println("11. JSON files:")
+	users := []User{
+		{Name: "Alice", Email: "alice@example.com", Age: 30},
+		{Name: "Bob", Email: "bob@example.com", Age: 25},
+	}
+	if err := SaveJSON("users.json", users); err != nil {
+		fmt.Println("Error saving JSON:", err)
+		return
+	}
+
+	// The type parameter says what to decode into
+	loaded, err := LoadJSON[[]User]("users.json")
+	if err != nil {
+		fmt.Println("Error loading JSON:", err)
+		return
+	}
+	fmt.Printf("✓ Loaded %d users: %+v\n", len(loaded), loaded)
+	fmt.Println()
+}
+
+// Example 12: Finding files in a directory tree
+func findFiles() {
+	fmt.Println("12. Finding files in a directory tree:")
+	paths, err := FindFiles(".", ".txt")
+	if err != nil {
+		fmt.Println("Error walking directory:", err)
+		return
+	}
+	fmt.Printf("Found %d .txt files:\n", len(paths))
+	for _, path := range paths {
+		fmt.Println(" -", path)
+	}
+	fmt.Println()
+}
+
+// Example 13: Reading the last lines of a file
+func tailFile() {
+	fmt.Println("13. Reading the last lines of a file:")
+	lines, err := TailLines("output.txt", 2)
+	if err != nil {
+		fmt.Println("Error reading file:", err)
+		return
+	}
+	for _, line := range lines {
+		fmt.Println(" ", line)
+	}
+	fmt.Println()
+}
 
+// Example 14: Compressing files
+func compressFile() {
+	fmt.Println("14. Compressing files:")
+	if err := GzipFile("output.txt", "output.txt.gz"); err != nil {
+		fmt.Println("Error compressing file:", err)
+		return
+	}
+	fmt.Println("✓ Compressed output.txt to output.txt.gz")
+
+	if err := GunzipFile("output.txt.gz", "output_unzipped.txt"); err != nil {
+		fmt.Println("Error decompressing file:", err)
+		return
+	}
+	fmt.Println("✓ Decompressed it again to output_unzipped.txt")
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+)
+
+// tailChunkSize is how many bytes TailLines reads at a time
+const tailChunkSize = 4096
+
+// TailLines returns the last n lines of the file at path, like the Unix
+// `tail -n` command. Instead of reading the whole file, it reads backwards
+// from the end in chunks until it has seen enough lines, so it's just as
+// fast on a 10 GB log as on a 10 line file. If the file has fewer than n
+// lines, all of them are returned.
+func TailLines(path string, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf []byte
+	newlines := 0
+	pos := info.Size()
+	for pos > 0 {
+		size := min(tailChunkSize, pos)
+		pos -= size
+
+		// ReadAt reads from an offset - like Seek followed by Read
+		chunk := make([]byte, size)
+		if _, err := file.ReadAt(chunk, pos); err != nil {
+			return nil, err
+		}
+		buf = append(chunk, buf...)
+
+		newlines += bytes.Count(chunk, []byte("\n"))
+		if pos+size == info.Size() && bytes.HasSuffix(chunk, []byte("\n")) {
+			newlines-- // The final newline ends the last line; it doesn't start a new one
+		}
+		// n newlines mean we have n complete lines after the first one
+		if newlines >= n {
+			break
+		}
+	}
+
+	if len(buf) == 0 {
+		return nil, nil
+	}
+	lines := strings.Split(strings.TrimSuffix(string(buf), "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r") // Windows line endings
+	}
+	return lines, nil
+}
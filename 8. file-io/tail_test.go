@@ -0,0 +1,76 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTailLinesLargeFile(t *testing.T) {
+	// 100,000 lines is about 1.2 MB - many chunks
+	path := filepath.Join(t.TempDir(), "large.log")
+	var sb strings.Builder
+	for i := 1; i <= 100000; i++ {
+		fmt.Fprintf(&sb, "line %d\n", i)
+	}
+	os.WriteFile(path, []byte(sb.String()), 0644)
+
+	got, err := TailLines(path, 3)
+	if err != nil {
+		t.Fatalf("TailLines returned error: %v", err)
+	}
+
+	expected := []string{"line 99998", "line 99999", "line 100000"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("TailLines(3) = %q; expected %q", got, expected)
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	longLine := strings.Repeat("x", 3*tailChunkSize) // Spans several chunks
+
+	tests := []struct {
+		name     string
+		content  string
+		n        int
+		expected []string
+	}{
+		{"last two", "a\nb\nc\n", 2, []string{"b", "c"}},
+		{"n equals line count", "a\nb\nc\n", 3, []string{"a", "b", "c"}},
+		{"n exceeds line count", "a\nb\nc\n", 10, []string{"a", "b", "c"}},
+		{"no trailing newline", "a\nb\nc", 2, []string{"b", "c"}},
+		{"blank lines kept", "a\n\nb\n\n", 3, []string{"", "b", ""}},
+		{"windows line endings", "a\r\nb\r\n", 2, []string{"a", "b"}},
+		{"line longer than a chunk", "first\n" + longLine + "\nlast\n", 2, []string{longLine, "last"}},
+		{"empty file", "", 5, nil},
+		{"zero lines", "a\nb\n", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "input.txt")
+			os.WriteFile(path, []byte(tt.content), 0644)
+
+			got, err := TailLines(path, tt.n)
+			if err != nil {
+				t.Fatalf("TailLines returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("TailLines(%d) = %q; expected %q", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTailLinesMissingFile(t *testing.T) {
+	_, err := TailLines(filepath.Join(t.TempDir(), "missing.txt"), 3)
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("TailLines error = %v; expected fs.ErrNotExist", err)
+	}
+}
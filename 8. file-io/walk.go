@@ -0,0 +1,35 @@
+package main
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// FindFiles returns the paths of all regular files under root whose
+// extension matches ext, ignoring case (".txt" matches "NOTES.TXT").
+// ext may be given with or without the leading dot. Symlinks are neither
+// followed nor returned. Paths come back in lexical order.
+func FindFiles(root, ext string) ([]string, error) {
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+
+	var matches []string
+	// WalkDir visits root and everything below it, one entry at a time.
+	// It never follows symlinks into other directories.
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err // Stop at the first directory we can't read
+		}
+		// Keep only regular files - no directories, symlinks or devices
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if strings.EqualFold(filepath.Ext(path), ext) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+	return matches, err
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFindFiles(t *testing.T) {
+	root := t.TempDir()
+	files := []string{
+		"a.txt",
+		"b.go",
+		"NOTES.TXT",
+		"docs/guide.txt",
+		"docs/guide.md",
+		"docs/deep/readme.Txt",
+		"docs/deep/archive.txt.gz",
+		"noext",
+	}
+	for _, f := range files {
+		path := filepath.Join(root, f)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A directory with a matching name is not a file
+	os.Mkdir(filepath.Join(root, "folder.txt"), 0755)
+	// Symlinks are skipped, whether they point at a file or a directory
+	os.Symlink(filepath.Join(root, "a.txt"), filepath.Join(root, "link.txt"))
+	os.Symlink(filepath.Join(root, "docs"), filepath.Join(root, "docs-link"))
+
+	expected := []string{
+		filepath.Join(root, "NOTES.TXT"),
+		filepath.Join(root, "a.txt"),
+		filepath.Join(root, "docs/deep/readme.Txt"),
+		filepath.Join(root, "docs/guide.txt"),
+	}
+
+	for _, ext := range []string{".txt", "txt", ".TXT"} {
+		t.Run(ext, func(t *testing.T) {
+			got, err := FindFiles(root, ext)
+			if err != nil {
+				t.Fatalf("FindFiles returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("FindFiles(root, %q) = %v; expected %v", ext, got, expected)
+			}
+		})
+	}
+}
+
+func TestFindFilesNoMatches(t *testing.T) {
+	root := t.TempDir()
+	os.WriteFile(filepath.Join(root, "main.go"), []byte("x"), 0644)
+
+	got, err := FindFiles(root, ".csv")
+
+	if err != nil || len(got) != 0 {
+		t.Errorf("FindFiles = %v, %v; expected no matches and no error", got, err)
+	}
+}
+
+func TestFindFilesMissingRoot(t *testing.T) {
+	_, err := FindFiles(filepath.Join(t.TempDir(), "missing"), ".txt")
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("FindFiles error = %v; expected fs.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,89 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// digits holds the symbols for every base up to 36
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+var (
+	// ErrInvalidBase is returned for bases outside 2-36
+	ErrInvalidBase = errors.New("base must be between 2 and 36")
+	// ErrInvalidDigit is returned when a string has a digit its base doesn't allow
+	ErrInvalidDigit = errors.New("invalid digit")
+	// ErrOutOfRange is returned when a string holds a number too big for an int
+	ErrOutOfRange = errors.New("number out of range")
+)
+
+// ToBase writes n in the given base (2-36), using lowercase letters for
+// digits above 9. Negative numbers get a leading minus: ToBase(-10, 2)
+// is "-1010".
+func ToBase(n, base int) (string, error) {
+	if base < 2 || base > 36 {
+		return "", fmt.Errorf("%w: got %d", ErrInvalidBase, base)
+	}
+	if n == 0 {
+		return "0", nil
+	}
+
+	// Work on the size as a uint64, because -math.MinInt doesn't fit in an int
+	negative := n < 0
+	magnitude := uint64(n)
+	if negative {
+		magnitude = -magnitude
+	}
+
+	// Collect digits from the right, then reverse them
+	var result []byte
+	for magnitude > 0 {
+		result = append(result, digits[magnitude%uint64(base)])
+		magnitude /= uint64(base)
+	}
+	if negative {
+		result = append(result, '-')
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return string(result), nil
+}
+
+// FromBase reads s as a number in the given base (2-36). Letters may be
+// upper or lower case, and a leading minus makes the result negative.
+func FromBase(s string, base int) (int, error) {
+	if base < 2 || base > 36 {
+		return 0, fmt.Errorf("%w: got %d", ErrInvalidBase, base)
+	}
+
+	digitsPart, negative := strings.CutPrefix(s, "-")
+	if digitsPart == "" {
+		return 0, fmt.Errorf("%w: %q has no digits", ErrInvalidDigit, s)
+	}
+
+	// The largest size allowed: MaxInt, or one more for negative numbers (MinInt)
+	limit := uint64(math.MaxInt)
+	if negative {
+		limit++
+	}
+
+	var magnitude uint64
+	for _, r := range strings.ToLower(digitsPart) {
+		value := strings.IndexRune(digits, r)
+		if value < 0 || value >= base {
+			return 0, fmt.Errorf("%w %q in %q for base %d", ErrInvalidDigit, r, s, base)
+		}
+		if magnitude > (limit-uint64(value))/uint64(base) {
+			return 0, fmt.Errorf("%w: %q", ErrOutOfRange, s)
+		}
+		magnitude = magnitude*uint64(base) + uint64(value)
+	}
+
+	if negative {
+		return int(-magnitude), nil
+	}
+	return int(magnitude), nil
+}
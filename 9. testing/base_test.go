@@ -0,0 +1,104 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestToBase(t *testing.T) {
+	tests := []struct {
+		n        int
+		base     int
+		expected string
+	}{
+		{0, 2, "0"},
+		{10, 2, "1010"},
+		{-10, 2, "-1010"},
+		{8, 8, "10"},
+		{255, 16, "ff"},
+		{-255, 16, "-ff"},
+		{35, 36, "z"},
+		{math.MaxInt, 16, strconv.FormatInt(math.MaxInt, 16)},
+		{math.MinInt, 2, strconv.FormatInt(math.MinInt, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result, err := ToBase(tt.n, tt.base)
+			if err != nil || result != tt.expected {
+				t.Errorf("ToBase(%d, %d) = %q, %v; expected %q", tt.n, tt.base, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBaseRoundTrip(t *testing.T) {
+	numbers := []int{0, 1, -1, 7, 42, -42, 255, 1000, -65535, math.MaxInt, math.MinInt}
+	bases := []int{2, 8, 16, 36}
+
+	for _, base := range bases {
+		for _, n := range numbers {
+			s, err := ToBase(n, base)
+			if err != nil {
+				t.Fatalf("ToBase(%d, %d) error = %v", n, base, err)
+			}
+			back, err := FromBase(s, base)
+			if err != nil || back != n {
+				t.Errorf("FromBase(%q, %d) = %d, %v; expected %d", s, base, back, err, n)
+			}
+		}
+	}
+}
+
+func TestFromBase(t *testing.T) {
+	tests := []struct {
+		s        string
+		base     int
+		expected int
+	}{
+		{"1010", 2, 10},
+		{"-1010", 2, -10},
+		{"777", 8, 511},
+		{"FF", 16, 255},
+		{"Ff", 16, 255},
+		{"Z", 36, 35},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			result, err := FromBase(tt.s, tt.base)
+			if err != nil || result != tt.expected {
+				t.Errorf("FromBase(%q, %d) = %d, %v; expected %d", tt.s, tt.base, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBaseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func() error
+		expected error
+	}{
+		{"ToBase base too small", func() error { _, err := ToBase(5, 1); return err }, ErrInvalidBase},
+		{"ToBase base too large", func() error { _, err := ToBase(5, 37); return err }, ErrInvalidBase},
+		{"FromBase base too small", func() error { _, err := FromBase("1", 0); return err }, ErrInvalidBase},
+		{"FromBase base too large", func() error { _, err := FromBase("1", 37); return err }, ErrInvalidBase},
+		{"digit too big for base", func() error { _, err := FromBase("102", 2); return err }, ErrInvalidDigit},
+		{"hex digit in octal", func() error { _, err := FromBase("7a", 8); return err }, ErrInvalidDigit},
+		{"not a digit", func() error { _, err := FromBase("1_000", 10); return err }, ErrInvalidDigit},
+		{"empty string", func() error { _, err := FromBase("", 10); return err }, ErrInvalidDigit},
+		{"only a minus", func() error { _, err := FromBase("-", 10); return err }, ErrInvalidDigit},
+		{"too big", func() error { _, err := FromBase("8000000000000000", 16); return err }, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.expected) {
+				t.Errorf("error = %v; expected %v", err, tt.expected)
+			}
+		})
+	}
+}
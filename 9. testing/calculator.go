@@ -1,5 +1,10 @@
 package calculator
 
+import (
+	"cmp"
+	"math"
+)
+
 // Add returns the sum of two integers
 func Add(a, b int) int {
 	return a + b
@@ -36,3 +41,42 @@ func Fibonacci(n int) int {
 	}
 	return Fibonacci(n-1) + Fibonacci(n-2)
 }
+
+// Clamp limits v to the range [lo, hi]: values below lo become lo and
+// values above hi become hi. It works for any ordered type (ints, floats,
+// strings). It panics if lo > hi, since no value could satisfy both.
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	if lo > hi {
+		panic("Clamp: lo is greater than hi")
+	}
+	return min(max(v, lo), hi)
+}
+
+// Round rounds value to the given number of decimal places, with halves
+// rounded away from zero. Negative places round to the left of the decimal
+// point: -1 rounds to tens, -2 to hundreds and so on.
+func Round(value float64, places int) float64 {
+	if places < 0 {
+		// Divide first so the scale is a whole number (100, not 0.01),
+		// which avoids results like 1200.0000000000002
+		scale := math.Pow(10, float64(-places))
+		return math.Round(value/scale) * scale
+	}
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
+
+// PercentOf returns what percentage part is of whole: PercentOf(25, 200)
+// is 12.5. It returns ErrDivisionByZero if whole is 0.
+func PercentOf(part, whole float64) (float64, error) {
+	if whole == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return part / whole * 100, nil
+}
+
+// ApplyPercent returns value increased by percent: ApplyPercent(100, 10)
+// is 110. A negative percent decreases it instead.
+func ApplyPercent(value, percent float64) float64 {
+	return value * (1 + percent/100)
+}
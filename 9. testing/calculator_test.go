@@ -1,6 +1,10 @@
 package calculator
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"testing"
+)
 
 // Example 1: Basic test
 func TestAdd(t *testing.T) {
@@ -133,3 +137,151 @@ func TestWithHelper(t *testing.T) {
 	result := Add(5, 7)
 	assertEqual(t, result, 12)
 }
+
+// Example 9: Testing a generic function with several types
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        int
+		expected int
+	}{
+		{"below range", -5, 0},
+		{"at lower bound", 0, 0},
+		{"in range", 5, 5},
+		{"at upper bound", 10, 10},
+		{"above range", 15, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Clamp(tt.v, 0, 10)
+			if result != tt.expected {
+				t.Errorf("Clamp(%d, 0, 10) = %d; expected %d", tt.v, result, tt.expected)
+			}
+		})
+	}
+
+	if result := Clamp(1.5, 0.0, 1.0); result != 1.0 {
+		t.Errorf("Clamp(1.5, 0.0, 1.0) = %v; expected 1.0", result)
+	}
+	if result := Clamp("apple", "banana", "cherry"); result != "banana" {
+		t.Errorf(`Clamp("apple", "banana", "cherry") = %q; expected "banana"`, result)
+	}
+	if result := Clamp(7, 7, 7); result != 7 {
+		t.Errorf("Clamp(7, 7, 7) = %d; expected 7", result)
+	}
+}
+
+// Example 10: Testing that a function panics
+func TestClampInvalidRange(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected Clamp(5, 10, 0) to panic")
+		}
+	}()
+
+	Clamp(5, 10, 0)
+}
+
+// Example 11: Fuzz test (Go generates random inputs)
+// Run with: go test -fuzz=FuzzDivide -fuzztime=30s
+// A plain `go test` only runs the seeds below and the files in
+// testdata/fuzz/FuzzDivide, so it stays fast.
+func FuzzDivide(f *testing.F) {
+	// Seed corpus: examples the fuzzer starts from and mutates
+	f.Add(10, 2)
+	f.Add(7, 3)
+	f.Add(-7, 3)
+	f.Add(5, 0)
+	f.Add(math.MinInt, -1) // Overflows: -MinInt doesn't fit in an int
+
+	f.Fuzz(func(t *testing.T, a, b int) {
+		result := Divide(a, b) // A panic here fails the test too
+
+		if b == 0 {
+			if result != 0 {
+				t.Errorf("Divide(%d, 0) = %d; expected 0", a, result)
+			}
+			return
+		}
+		// Quotient times divisor plus remainder gives back the dividend
+		if result*b+a%b != a {
+			t.Errorf("Divide(%d, %d)*%d + %d%%%d != %d (quotient %d)", a, b, b, a, b, a, result)
+		}
+	})
+}
+
+// Example 12: Testing float results (see approxEqual in stats_test.go)
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		places   int
+		expected float64
+	}{
+		{"two places", 2.345, 2, 2.35},
+		{"one place", 3.14159, 1, 3.1},
+		{"zero places", 2.5, 0, 3},
+		{"negative number", -2.345, 2, -2.35},
+		{"negative number rounds away from zero", -2.5, 0, -3},
+		{"already rounded", 1.5, 3, 1.5},
+		{"tens", 1234.5, -1, 1230},
+		{"hundreds", 1234.5, -2, 1200},
+		{"hundreds rounding up", 1250, -2, 1300},
+		{"negative hundreds", -1250, -2, -1300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Round(tt.value, tt.places)
+			if !approxEqual(result, tt.expected) {
+				t.Errorf("Round(%v, %d) = %v; expected %v", tt.value, tt.places, result, tt.expected)
+			}
+		})
+	}
+}
+
+// Example 13: Testing a function that returns a value and an error
+func TestPercentOf(t *testing.T) {
+	tests := []struct {
+		part, whole float64
+		expected    float64
+	}{
+		{25, 200, 12.5},
+		{50, 50, 100},
+		{0, 10, 0},
+		{30, 20, 150},
+		{-5, 20, -25},
+	}
+
+	for _, tt := range tests {
+		result, err := PercentOf(tt.part, tt.whole)
+		if err != nil || !approxEqual(result, tt.expected) {
+			t.Errorf("PercentOf(%v, %v) = %v, %v; expected %v", tt.part, tt.whole, result, err, tt.expected)
+		}
+	}
+
+	if _, err := PercentOf(25, 0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("PercentOf(25, 0) error = %v; expected ErrDivisionByZero", err)
+	}
+}
+
+func TestApplyPercent(t *testing.T) {
+	tests := []struct {
+		value, percent float64
+		expected       float64
+	}{
+		{100, 10, 110},
+		{100, 0, 100},
+		{100, -25, 75},
+		{80, 12.5, 90},
+		{0, 50, 0},
+	}
+
+	for _, tt := range tests {
+		result := ApplyPercent(tt.value, tt.percent)
+		if !approxEqual(result, tt.expected) {
+			t.Errorf("ApplyPercent(%v, %v) = %v; expected %v", tt.value, tt.percent, result, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+var (
+	// ErrMalformedExpression is returned when Eval can't parse its input
+	ErrMalformedExpression = errors.New("malformed expression")
+	// ErrDivisionByZero is returned when an expression divides by zero
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// Eval evaluates an infix expression such as "(2+3)*4" using +, -, *, /
+// and parentheses. * and / bind tighter than + and -, operators of the same
+// precedence run left to right, and a leading minus negates ("-2*3").
+// Spaces are ignored.
+//
+// It is a recursive descent parser with one function per grammar rule:
+//
+//	expression = term { ("+" | "-") term }
+//	term       = factor { ("*" | "/") factor }
+//	factor     = number | "(" expression ")" | "-" factor
+func Eval(expr string) (float64, error) {
+	p := &parser{input: []rune(expr)}
+	result, err := p.expression()
+	if err != nil {
+		return 0, err
+	}
+	if p.skipSpaces(); p.pos < len(p.input) {
+		return 0, p.errorf("unexpected %q", p.input[p.pos])
+	}
+	return result, nil
+}
+
+// parser holds the input and how far Eval has read into it
+type parser struct {
+	input []rune
+	pos   int
+}
+
+// expression parses a sum or difference of terms
+func (p *parser) expression() (float64, error) {
+	result, err := p.term()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op, ok := p.consume('+', '-')
+		if !ok {
+			return result, nil
+		}
+		right, err := p.term()
+		if err != nil {
+			return 0, err
+		}
+		if op == '+' {
+			result += right
+		} else {
+			result -= right
+		}
+	}
+}
+
+// term parses a product or quotient of factors
+func (p *parser) term() (float64, error) {
+	result, err := p.factor()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op, ok := p.consume('*', '/')
+		if !ok {
+			return result, nil
+		}
+		right, err := p.factor()
+		if err != nil {
+			return 0, err
+		}
+		if op == '*' {
+			result *= right
+		} else {
+			if right == 0 {
+				return 0, ErrDivisionByZero
+			}
+			result /= right
+		}
+	}
+}
+
+// factor parses a number, a bracketed expression or a negated factor
+func (p *parser) factor() (float64, error) {
+	if _, ok := p.consume('-'); ok {
+		value, err := p.factor()
+		return -value, err
+	}
+	if _, ok := p.consume('('); ok {
+		value, err := p.expression()
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := p.consume(')'); !ok {
+			return 0, p.errorf("missing closing parenthesis")
+		}
+		return value, nil
+	}
+	return p.number()
+}
+
+// number parses a run of digits with an optional decimal point
+func (p *parser) number() (float64, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) && (unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '.') {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.pos == len(p.input) {
+			return 0, p.errorf("unexpected end of expression")
+		}
+		return 0, p.errorf("unexpected %q", p.input[p.pos])
+	}
+	text := string(p.input[start:p.pos])
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, p.errorf("invalid number %q", text)
+	}
+	return value, nil
+}
+
+// consume skips spaces, then moves past the next rune if it is one of ops
+func (p *parser) consume(ops ...rune) (rune, bool) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return 0, false
+	}
+	for _, op := range ops {
+		if p.input[p.pos] == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return 0, false
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// errorf wraps ErrMalformedExpression with a message and the current position
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at position %d: %s", ErrMalformedExpression, p.pos, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,62 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected float64
+	}{
+		{"2+3*4", 14},
+		{"(2+3)*4", 20},
+		{"42", 42},
+		{"10-4-3", 3},   // Left to right: (10-4)-3
+		{"100/10/5", 2}, // Left to right: (100/10)/5
+		{"7/2", 3.5},
+		{"1.5*4", 6},
+		{" 2 * ( 3 + 4 ) ", 14},
+		{"-2*3", -6},
+		{"-(2+3)", -5},
+		{"4--2", 6},
+		{"((1+2)*(3+4))/7", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := Eval(tt.expr)
+			if err != nil || !approxEqual(result, tt.expected) {
+				t.Errorf("Eval(%q) = %v, %v; expected %v", tt.expr, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected error
+	}{
+		{"2+*3", ErrMalformedExpression},
+		{"", ErrMalformedExpression},
+		{"2+", ErrMalformedExpression},
+		{"(2+3", ErrMalformedExpression},
+		{"2+3)", ErrMalformedExpression},
+		{"2 3", ErrMalformedExpression},
+		{"1.2.3", ErrMalformedExpression},
+		{"2^3", ErrMalformedExpression},
+		{"1/0", ErrDivisionByZero},
+		{"5/(2-2)", ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := Eval(tt.expr)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Eval(%q) = %v, %v; expected error %v", tt.expr, result, err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package calculator_test
+
+import (
+	"fmt"
+
+	"calculator"
+)
+
+// Example functions are tests and documentation at once: go test runs
+// them and compares what they print with the "Output:" comment, and
+// go doc shows them next to the function they're named after.
+// This file uses package calculator_test, so like a real user it can only
+// reach the exported names, through the calculator. prefix.
+
+func ExampleAdd() {
+	fmt.Println(calculator.Add(2, 3))
+	fmt.Println(calculator.Add(-4, 1))
+	// Output:
+	// 5
+	// -3
+}
+
+func ExampleMultiply() {
+	fmt.Println(calculator.Multiply(3, 4))
+	fmt.Println(calculator.Multiply(-2, 3))
+	fmt.Println(calculator.Multiply(7, 0))
+	// Output:
+	// 12
+	// -6
+	// 0
+}
+
+// The lines must appear in exactly this order for the example to pass
+func ExampleIsEven() {
+	for n := 1; n <= 4; n++ {
+		fmt.Println(n, calculator.IsEven(n))
+	}
+	// Output:
+	// 1 false
+	// 2 true
+	// 3 false
+	// 4 true
+}
+
+// Map iteration order is random, so this example uses "Unordered output:"
+// which only checks that the same lines appear, in any order
+func ExampleIsEven_unordered() {
+	numbers := map[string]int{"zero": 0, "seven": 7, "ten": 10}
+	for name, n := range numbers {
+		fmt.Println(name, calculator.IsEven(n))
+	}
+	// Unordered output:
+	// zero true
+	// seven false
+	// ten true
+}
@@ -0,0 +1,54 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// ErrEmptyInput is returned by the statistics functions for an empty slice
+var ErrEmptyInput = errors.New("empty input")
+
+// Mean returns the average of nums
+func Mean(nums []float64) (float64, error) {
+	if len(nums) == 0 {
+		return 0, ErrEmptyInput
+	}
+	sum := 0.0
+	for _, n := range nums {
+		sum += n
+	}
+	return sum / float64(len(nums)), nil
+}
+
+// Median returns the middle value of nums once sorted. For an even number
+// of values it returns the average of the two middle ones.
+// nums itself is not reordered; a sorted copy is used.
+func Median(nums []float64) (float64, error) {
+	if len(nums) == 0 {
+		return 0, ErrEmptyInput
+	}
+	sorted := slices.Clone(nums)
+	slices.Sort(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2, nil
+	}
+	return sorted[middle], nil
+}
+
+// StdDev returns the population standard deviation of nums: the square
+// root of the average squared distance from the mean
+func StdDev(nums []float64) (float64, error) {
+	mean, err := Mean(nums)
+	if err != nil {
+		return 0, err
+	}
+	sumSquares := 0.0
+	for _, n := range nums {
+		diff := n - mean
+		sumSquares += diff * diff
+	}
+	return math.Sqrt(sumSquares / float64(len(nums))), nil
+}
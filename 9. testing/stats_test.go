@@ -0,0 +1,75 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// approxEqual compares floats with a small tolerance for rounding errors
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestStatistics(t *testing.T) {
+	tests := []struct {
+		name           string
+		nums           []float64
+		expectedMean   float64
+		expectedMedian float64
+		expectedStdDev float64
+	}{
+		{"single element", []float64{7}, 7, 7, 0},
+		{"odd length", []float64{3, 1, 2}, 2, 2, math.Sqrt(2.0 / 3.0)},
+		{"even length averages the middle two", []float64{4, 1, 3, 2}, 2.5, 2.5, math.Sqrt(1.25)},
+		{"textbook example", []float64{2, 4, 4, 4, 5, 5, 7, 9}, 5, 4.5, 2},
+		{"negative values", []float64{-5, 5, -1, 1}, 0, 0, math.Sqrt(13)},
+		{"all equal", []float64{3, 3, 3}, 3, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, err := Mean(tt.nums)
+			if err != nil || !approxEqual(mean, tt.expectedMean) {
+				t.Errorf("Mean(%v) = %v, %v; expected %v", tt.nums, mean, err, tt.expectedMean)
+			}
+			median, err := Median(tt.nums)
+			if err != nil || !approxEqual(median, tt.expectedMedian) {
+				t.Errorf("Median(%v) = %v, %v; expected %v", tt.nums, median, err, tt.expectedMedian)
+			}
+			stdDev, err := StdDev(tt.nums)
+			if err != nil || !approxEqual(stdDev, tt.expectedStdDev) {
+				t.Errorf("StdDev(%v) = %v, %v; expected %v", tt.nums, stdDev, err, tt.expectedStdDev)
+			}
+		})
+	}
+}
+
+func TestStatisticsEmptyInput(t *testing.T) {
+	functions := map[string]func([]float64) (float64, error){
+		"Mean":   Mean,
+		"Median": Median,
+		"StdDev": StdDev,
+	}
+
+	for name, f := range functions {
+		if _, err := f(nil); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("%s(nil) error = %v; expected ErrEmptyInput", name, err)
+		}
+		if _, err := f([]float64{}); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("%s([]) error = %v; expected ErrEmptyInput", name, err)
+		}
+	}
+}
+
+func TestMedianDoesNotModifyInput(t *testing.T) {
+	nums := []float64{5, 1, 4, 2, 3}
+
+	Median(nums)
+
+	expected := []float64{5, 1, 4, 2, 3}
+	if !reflect.DeepEqual(nums, expected) {
+		t.Errorf("after Median, input = %v; expected it unchanged (%v)", nums, expected)
+	}
+}
@@ -0,0 +1,160 @@
+// Package examples is a small runner shared by the lesson programs. Each
+// lesson registers its sections as named demos, and the user picks one with
+// the -demo flag instead of always running the whole script:
+//
+//	go run . -demo maps           # every demo in the "maps" group
+//	go run . -demo maps/iterating # just one
+//	go run . -list                # show the names
+//
+// Pressing Ctrl-C cancels the context passed to the demos, so the running
+// demo can stop cleanly and no further demos start.
+package examples
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+// ErrUnknownDemo is returned when no demo or group matches a name
+var ErrUnknownDemo = errors.New("unknown demo")
+
+// Demo is one runnable section of a lesson. ctx is cancelled on Ctrl-C;
+// demos that wait on something should give up when it is.
+type Demo func(ctx context.Context)
+
+// Plain adapts a demo that finishes quickly and doesn't need the context
+func Plain(fn func()) Demo {
+	return func(context.Context) { fn() }
+}
+
+// Registry maps a name (like "maps/iterating") to the demo that runs it.
+// The part before the "/" is the group, usually the lesson name.
+type Registry struct {
+	names []string        // Registration order, so RunAll is predictable
+	demos map[string]Demo // Name -> demo function
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		demos: make(map[string]Demo),
+	}
+}
+
+// Register adds a demo under name (registering a name again replaces it)
+func (r *Registry) Register(name string, demo Demo) {
+	if _, exists := r.demos[name]; !exists {
+		r.names = append(r.names, name)
+	}
+	r.demos[name] = demo
+}
+
+// Names returns the registered demo names in registration order
+func (r *Registry) Names() []string {
+	return append([]string(nil), r.names...)
+}
+
+// Run runs the demo registered under name. If name is a group such as
+// "maps", every demo named "maps/..." runs in registration order.
+// It stops before the next demo once ctx is cancelled and returns ctx.Err().
+func (r *Registry) Run(ctx context.Context, name string) error {
+	if demo, ok := r.demos[name]; ok {
+		return runDemo(ctx, demo)
+	}
+
+	var group []string
+	for _, n := range r.names {
+		if strings.HasPrefix(n, name+"/") {
+			group = append(group, n)
+		}
+	}
+	if name == "" || len(group) == 0 {
+		return fmt.Errorf("%w %q (available: %s)", ErrUnknownDemo, name, strings.Join(r.names, ", "))
+	}
+	return r.runNames(ctx, group)
+}
+
+// RunAll runs every demo in the order they were registered, stopping early
+// once ctx is cancelled
+func (r *Registry) RunAll(ctx context.Context) error {
+	return r.runNames(ctx, r.names)
+}
+
+func (r *Registry) runNames(ctx context.Context, names []string) error {
+	for _, name := range names {
+		if err := runDemo(ctx, r.demos[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runDemo runs demo unless ctx is already cancelled, and reports whether
+// ctx was cancelled while it ran
+func runDemo(ctx context.Context, demo Demo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	demo(ctx)
+	return ctx.Err()
+}
+
+// Execute reads the -demo and -list flags from args and runs the chosen
+// demos, or all of them when -demo is not given
+func (r *Registry) Execute(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("examples", flag.ContinueOnError)
+	flags.SetOutput(out)
+	demoName := flags.String("demo", "", "run one demo or group, e.g. -demo "+r.exampleName())
+	list := flags.Bool("list", false, "list the demo names and exit")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *list {
+		for _, name := range r.names {
+			fmt.Fprintln(out, name)
+		}
+		return nil
+	}
+	if *demoName == "" {
+		return r.RunAll(ctx)
+	}
+	return r.Run(ctx, *demoName)
+}
+
+// exampleName picks a registered name to show in the -demo help text
+func (r *Registry) exampleName() string {
+	if len(r.names) == 0 {
+		return "maps"
+	}
+	return r.names[0]
+}
+
+// Main is what a lesson's main calls after registering its demos. It runs
+// them with the command-line flags and cancels the context on Ctrl-C.
+// It exits with status 1 on an error and 130 (the shell's convention for
+// Ctrl-C) when interrupted.
+func Main(r *Registry) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := r.Execute(ctx, os.Args[1:], os.Stdout)
+	switch {
+	case errors.Is(err, context.Canceled):
+		fmt.Println("\nInterrupted - stopped early")
+		stop()
+		os.Exit(130)
+	case errors.Is(err, flag.ErrHelp):
+		return
+	case err != nil:
+		fmt.Println("Error:", err)
+		stop()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,129 @@
+package examples
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// recordingRegistry registers a demo for each name that appends the name
+// to *ran when it runs
+func recordingRegistry(ran *[]string, names ...string) *Registry {
+	r := NewRegistry()
+	for _, name := range names {
+		r.Register(name, Plain(func() { *ran = append(*ran, name) }))
+	}
+	return r
+}
+
+func TestRegistryRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		demo     string
+		expected []string
+	}{
+		{"single demo", "maps/iterating", []string{"maps/iterating"}},
+		{"whole group", "maps", []string{"maps/creating", "maps/iterating"}},
+		{"other group", "slices", []string{"slices/append"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ran []string
+			r := recordingRegistry(&ran, "maps/creating", "slices/append", "maps/iterating")
+
+			if err := r.Run(context.Background(), tt.demo); err != nil {
+				t.Fatalf("Run(%q) error = %v", tt.demo, err)
+			}
+			if !reflect.DeepEqual(ran, tt.expected) {
+				t.Errorf("Run(%q) ran %v; expected %v", tt.demo, ran, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegistryUnknownName(t *testing.T) {
+	var ran []string
+	r := recordingRegistry(&ran, "maps/creating")
+
+	// "map" is a prefix of "maps/creating" but not a group name
+	for _, name := range []string{"missing", "map", ""} {
+		if err := r.Run(context.Background(), name); !errors.Is(err, ErrUnknownDemo) {
+			t.Errorf("Run(%q) error = %v; expected ErrUnknownDemo", name, err)
+		}
+	}
+	if len(ran) != 0 {
+		t.Errorf("ran %v; expected nothing", ran)
+	}
+}
+
+func TestRegistryRunAllInOrder(t *testing.T) {
+	var ran []string
+	r := recordingRegistry(&ran, "c", "a", "b")
+
+	if err := r.RunAll(context.Background()); err != nil {
+		t.Fatalf("RunAll error = %v", err)
+	}
+
+	expected := []string{"c", "a", "b"}
+	if !reflect.DeepEqual(ran, expected) {
+		t.Errorf("RunAll order = %v; expected registration order %v", ran, expected)
+	}
+	if !reflect.DeepEqual(r.Names(), expected) {
+		t.Errorf("Names() = %v; expected %v", r.Names(), expected)
+	}
+}
+
+func TestRegistryStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ran []string
+	r := NewRegistry()
+	r.Register("first", func(ctx context.Context) {
+		ran = append(ran, "first")
+		cancel() // As if Ctrl-C was pressed while this demo ran
+	})
+	r.Register("second", Plain(func() { ran = append(ran, "second") }))
+
+	err := r.RunAll(ctx)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("RunAll error = %v; expected context.Canceled", err)
+	}
+	if !reflect.DeepEqual(ran, []string{"first"}) {
+		t.Errorf("ran %v; expected only [first]", ran)
+	}
+}
+
+func TestExecuteFlags(t *testing.T) {
+	var ran []string
+	r := recordingRegistry(&ran, "maps/creating", "maps/iterating")
+
+	var out bytes.Buffer
+	if err := r.Execute(context.Background(), []string{"-demo", "maps/iterating"}, &out); err != nil {
+		t.Fatalf("Execute(-demo) error = %v", err)
+	}
+	if !reflect.DeepEqual(ran, []string{"maps/iterating"}) {
+		t.Errorf("Execute(-demo maps/iterating) ran %v", ran)
+	}
+
+	ran = nil
+	if err := r.Execute(context.Background(), nil, &out); err != nil || len(ran) != 2 {
+		t.Errorf("Execute() ran %v, error %v; expected both demos", ran, err)
+	}
+
+	ran, out = nil, bytes.Buffer{}
+	if err := r.Execute(context.Background(), []string{"-list"}, &out); err != nil || len(ran) != 0 {
+		t.Errorf("Execute(-list) ran %v, error %v; expected no demos", ran, err)
+	}
+	if got := out.String(); got != "maps/creating\nmaps/iterating\n" {
+		t.Errorf("Execute(-list) printed %q", got)
+	}
+
+	if err := r.Execute(context.Background(), []string{"-demo", "nope"}, &out); !errors.Is(err, ErrUnknownDemo) {
+		t.Errorf("Execute(-demo nope) error = %v; expected ErrUnknownDemo", err)
+	}
+}